@@ -1,11 +1,21 @@
 package main
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/opsgenie/terraform-provider-opsgenie/opsgenie"
 )
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: opsgenie.Provider})
+	serverFactory, err := opsgenie.ProtoV6ProviderServerFactory(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = tf6server.Serve("registry.terraform.io/opsgenie/opsgenie", serverFactory)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
@@ -1,12 +1,16 @@
 package opsgenie
 
 import (
+	"errors"
 	"log"
+	"sync"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
 )
 
+const defaultApiUrl = "api.opsgenie.com"
+
 type OpsgenieClient struct {
 	client *client.OpsGenieClient
 }
@@ -16,7 +20,29 @@ type Config struct {
 	ApiUrl string
 }
 
+// clients caches the OpsGenie clients by configuration, so that the SDKv2 and
+// the terraform-plugin-framework providers served through the mux share the
+// same client.
+var (
+	clientsMu sync.Mutex
+	clients   = map[Config]*OpsgenieClient{}
+)
+
+func (c *Config) validate() error {
+	if c.ApiKey == "" {
+		return errors.New("api_key must be set in the provider configuration or with the OPSGENIE_API_KEY environment variable")
+	}
+	return nil
+}
+
 func (c *Config) Client() (*OpsgenieClient, error) {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	if ogClient, ok := clients[*c]; ok {
+		return ogClient, nil
+	}
+
 	config := &client.Config{
 		ApiKey:         c.ApiKey,
 		RetryCount:     10,
@@ -29,6 +55,7 @@ func (c *Config) Client() (*OpsgenieClient, error) {
 	}
 	ogClient := OpsgenieClient{}
 	ogClient.client = ogCli
+	clients[*c] = &ogClient
 	log.Printf("[INFO] OpsGenie client configured")
 	return &ogClient, nil
 }
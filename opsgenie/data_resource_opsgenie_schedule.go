@@ -5,12 +5,13 @@ import (
 
 	"github.com/opsgenie/opsgenie-go-sdk-v2/schedule"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceOpsgenieSchedule() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceOpsgenieScheduleRead,
+		ReadContext: dataSourceOpsgenieScheduleRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -38,10 +39,10 @@ func dataSourceOpsgenieSchedule() *schema.Resource {
 	}
 }
 
-func dataSourceOpsgenieScheduleRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceOpsgenieScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := schedule.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	scheduleName := d.Get("name").(string)
 
@@ -50,9 +51,9 @@ func dataSourceOpsgenieScheduleRead(d *schema.ResourceData, meta interface{}) er
 		IdentifierValue: scheduleName,
 	}
 
-	getResponse, err := client.Get(context.Background(), getRequest)
+	getResponse, err := client.Get(ctx, getRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(getResponse.Schedule.Id)
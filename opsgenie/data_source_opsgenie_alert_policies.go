@@ -0,0 +1,37 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/policy"
+)
+
+func dataSourceOpsGenieAlertPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsGenieAlertPoliciesRead,
+		Schema:      policyListDataSourceSchema(false),
+	}
+}
+
+func dataSourceOpsGenieAlertPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := policy.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	log.Printf("[INFO] Listing OpsGenie Alert Policies of team '%s'", d.Get("team_id").(string))
+
+	result, err := client.ListAlertPolicies(ctx, &policy.ListAlertPoliciesRequest{
+		TeamId: d.Get("team_id").(string),
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	setOpsGeniePolicyList(d, result.Policies)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package opsgenie
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOpsGenieAlertPolicies_Basic(t *testing.T) {
+	randomAlertPolicyName := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieAlertPoliciesConfig(randomAlertPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opsgenie_alert_policies.test", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_alert_policies.test", "policies.0.id", "opsgenie_alert_policy.test", "id"),
+					resource.TestCheckResourceAttr("data.opsgenie_alert_policies.test", "policies.0.type", "alert"),
+					resource.TestCheckResourceAttr("data.opsgenie_alert_policies.test", "policies.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpsGenieAlertPoliciesConfig(alertPolicyName string) string {
+	return testAccOpsGenieAlertPolicy_basic(alertPolicyName) + `
+data "opsgenie_alert_policies" "test" {
+  name_regex = "^${opsgenie_alert_policy.test.name}$"
+  depends_on = [opsgenie_alert_policy.test]
+}
+`
+}
@@ -0,0 +1,45 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/policy"
+)
+
+func dataSourceOpsGenieAlertPolicy() *schema.Resource {
+	s := dataSourceSchemaFromResourceSchema(resourceOpsGenieAlertPolicy().Schema)
+	s["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s["team_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceOpsGenieAlertPolicyRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceOpsGenieAlertPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := policy.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	log.Printf("[INFO] Looking up OpsGenie Alert Policy '%s'", d.Get("name").(string))
+
+	result, err := client.ListAlertPolicies(ctx, &policy.ListAlertPoliciesRequest{
+		TeamId: d.Get("team_id").(string),
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	return readOpsGeniePolicyByName(ctx, d, meta, result.Policies, resourceOpsGenieAlertPolicyRead)
+}
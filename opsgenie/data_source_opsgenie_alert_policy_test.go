@@ -0,0 +1,36 @@
+package opsgenie
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOpsGenieAlertPolicy_Basic(t *testing.T) {
+	randomAlertPolicyName := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieAlertPolicyConfig(randomAlertPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.opsgenie_alert_policy.test", "id", "opsgenie_alert_policy.test", "id"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_alert_policy.test", "message", "opsgenie_alert_policy.test", "message"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_alert_policy.test", "policy_description", "opsgenie_alert_policy.test", "policy_description"),
+					resource.TestCheckResourceAttr("data.opsgenie_alert_policy.test", "time_restriction.0.restrictions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpsGenieAlertPolicyConfig(alertPolicyName string) string {
+	return testAccOpsGenieAlertPolicy_basic(alertPolicyName) + `
+data "opsgenie_alert_policy" "test" {
+  name       = opsgenie_alert_policy.test.name
+  depends_on = [opsgenie_alert_policy.test]
+}
+`
+}
@@ -0,0 +1,65 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/custom_user_role"
+)
+
+func dataSourceOpsgenieCustomRole() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsgenieCustomRoleRead,
+		Schema: map[string]*schema.Schema{
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"extended_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"granted_rights": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"disallowed_rights": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceOpsgenieCustomRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := custom_user_role.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	roleName := d.Get("role_name").(string)
+
+	log.Printf("[INFO] Reading OpsGenie custom role '%s'", roleName)
+
+	result, err := client.Get(ctx, &custom_user_role.GetRequest{
+		Identifier:     roleName,
+		IdentifierType: custom_user_role.Name,
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(result.Id)
+	d.Set("role_name", result.Name)
+	d.Set("extended_role", string(result.ExtendedRole))
+	d.Set("granted_rights", result.GrantedRights)
+	d.Set("disallowed_rights", result.DisallowedRights)
+
+	return nil
+}
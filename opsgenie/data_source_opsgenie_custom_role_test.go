@@ -0,0 +1,45 @@
+package opsgenie
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOpsgenieCustomRole_Basic(t *testing.T) {
+	randomName := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsgenieCustomRoleConfig(randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.opsgenie_custom_role.test", "id", "opsgenie_custom_role.test", "id"),
+					resource.TestCheckResourceAttr("data.opsgenie_custom_role.test", "extended_role", "user"),
+					resource.TestCheckResourceAttr("data.opsgenie_custom_role.test", "granted_rights.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.opsgenie_custom_role.test", "granted_rights.*", "alert-delete"),
+					resource.TestCheckResourceAttr("data.opsgenie_custom_role.test", "disallowed_rights.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.opsgenie_custom_role.test", "disallowed_rights.*", "profile-edit"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpsgenieCustomRoleConfig(randomName string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_custom_role" "test" {
+  role_name         = "genierole-%s"
+  extended_role     = "user"
+  granted_rights    = ["alert-delete"]
+  disallowed_rights = ["profile-edit"]
+}
+
+data "opsgenie_custom_role" "test" {
+  role_name = opsgenie_custom_role.test.role_name
+}
+`, randomName)
+}
@@ -3,15 +3,16 @@ package opsgenie
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/escalation"
 )
 
 func dataSourceOpsgenieEscalation() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceOpsgenieEscalationRead,
+		ReadContext: dataSourceOpsgenieEscalationRead,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -97,10 +98,10 @@ func dataSourceOpsgenieEscalation() *schema.Resource {
 	}
 }
 
-func dataSourceOpsgenieEscalationRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceOpsgenieEscalationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := escalation.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	escalationName := d.Get("name").(string)
 
@@ -109,9 +110,9 @@ func dataSourceOpsgenieEscalationRead(d *schema.ResourceData, meta interface{})
 		Identifier:     escalationName,
 	}
 
-	getResponse, err := client.Get(context.Background(), getRequest)
+	getResponse, err := client.Get(ctx, getRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(getResponse.Id)
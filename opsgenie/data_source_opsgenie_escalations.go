@@ -0,0 +1,94 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/escalation"
+)
+
+func dataSourceOpsgenieEscalations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsgenieEscalationsRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": nameRegexSchema(),
+			"owner_team_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"escalations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_team_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOpsgenieEscalationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := escalation.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	log.Printf("[INFO] Listing OpsGenie escalations")
+
+	// The escalations API returns every escalation at once, there is nothing to paginate
+	result, err := client.List(ctx)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	matchesName := nameRegexMatcher(d)
+	ownerTeamId := d.Get("owner_team_id").(string)
+	ids := make([]string, 0)
+	escalations := make([]map[string]interface{}, 0)
+	for _, e := range result.Escalations {
+		teamId := ""
+		if e.OwnerTeam != nil {
+			teamId = e.OwnerTeam.Id
+		}
+		if !matchesName(e.Name) || (ownerTeamId != "" && teamId != ownerTeamId) {
+			continue
+		}
+		ids = append(ids, e.Id)
+		escalations = append(escalations, map[string]interface{}{
+			"id":            e.Id,
+			"name":          e.Name,
+			"description":   e.Description,
+			"owner_team_id": teamId,
+		})
+	}
+
+	d.SetId(listDataSourceId(ids))
+	d.Set("ids", ids)
+	d.Set("escalations", escalations)
+
+	return nil
+}
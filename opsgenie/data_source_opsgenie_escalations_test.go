@@ -0,0 +1,55 @@
+package opsgenie
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOpsGenieEscalations_Basic(t *testing.T) {
+	randomUser := acctest.RandString(6)
+	randomEscalation := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieEscalationsConfig(randomUser, randomEscalation),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opsgenie_escalations.test", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_escalations.test", "escalations.0.id", "opsgenie_escalation.test", "id"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_escalations.test", "escalations.0.description", "opsgenie_escalation.test", "description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpsGenieEscalationsConfig(randomUser, randomEscalation string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_user" "test" {
+  username  = "genietest-%s@opsgenie.com"
+  full_name = "Acceptance Test User"
+  role      = "User"
+}
+resource "opsgenie_escalation" "test" {
+  name        = "genieescalations-%s"
+  description = "escalation test"
+  rules {
+    condition   = "if-not-acked"
+    notify_type = "default"
+    recipient {
+      type = "user"
+      id   = opsgenie_user.test.id
+    }
+    delay = 1
+  }
+}
+data "opsgenie_escalations" "test" {
+  name_regex = "^${opsgenie_escalation.test.name}$"
+  depends_on = [opsgenie_escalation.test]
+}
+`, randomUser, randomEscalation)
+}
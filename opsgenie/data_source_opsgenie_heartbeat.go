@@ -2,15 +2,16 @@ package opsgenie
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/heartbeat"
 )
 
 func dataSourceOpsgenieHeartbeat() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceOpsgenieHeartbeatRead,
+		ReadContext: dataSourceOpsgenieHeartbeatRead,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -58,17 +59,17 @@ func dataSourceOpsgenieHeartbeat() *schema.Resource {
 	}
 }
 
-func dataSourceOpsgenieHeartbeatRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceOpsgenieHeartbeatRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := heartbeat.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	heartbeatName := d.Get("name").(string)
 
-	result, err := client.Get(context.Background(), heartbeatName)
+	result, err := client.Get(ctx, heartbeatName)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(result.Name)
@@ -0,0 +1,188 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/heartbeat"
+)
+
+const (
+	heartbeatStatusActive   = "active"
+	heartbeatStatusExpired  = "expired"
+	heartbeatStatusDisabled = "disabled"
+)
+
+// The SDK does not expose the list request and its Heartbeat type lacks the
+// last ping time, so the list is decoded into these types instead.
+type heartbeatListRequest struct {
+	client.BaseRequest
+}
+
+func (r *heartbeatListRequest) Validate() error {
+	return nil
+}
+
+func (r *heartbeatListRequest) ResourcePath() string {
+	return "/v2/heartbeats"
+}
+
+func (r *heartbeatListRequest) Method() string {
+	return http.MethodGet
+}
+
+type heartbeatListResult struct {
+	client.ResultMetadata
+	Heartbeats []heartbeatWithLastPing `json:"heartbeats"`
+}
+
+type heartbeatWithLastPing struct {
+	heartbeat.Heartbeat
+	LastPingTime string `json:"lastPingTime,omitempty"`
+}
+
+func dataSourceOpsgenieHeartbeats() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsgenieHeartbeatsRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": nameRegexSchema(),
+			"owner_team_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{heartbeatStatusActive, heartbeatStatusExpired, heartbeatStatusDisabled}, false),
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"heartbeats": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interval": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"interval_unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"expired": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_ping_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_team_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alert_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alert_priority": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alert_tags": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOpsgenieHeartbeatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Listing OpsGenie heartbeats")
+
+	// The heartbeats API returns every heartbeat at once, there is nothing to paginate
+	list := &heartbeatListResult{}
+	if err := meta.(*OpsgenieClient).client.Exec(ctx, &heartbeatListRequest{}, list); err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	matchesName := nameRegexMatcher(d)
+	ownerTeamId := d.Get("owner_team_id").(string)
+	status := d.Get("status").(string)
+
+	names := make([]string, 0)
+	heartbeats := make([]map[string]interface{}, 0)
+	for _, h := range list.Heartbeats {
+		heartbeatStatus := opsgenieHeartbeatStatus(h.Enabled, h.Expired)
+		if !matchesName(h.Name) ||
+			(ownerTeamId != "" && h.OwnerTeam.Id != ownerTeamId) ||
+			(status != "" && heartbeatStatus != status) {
+			continue
+		}
+
+		names = append(names, h.Name)
+		heartbeats = append(heartbeats, map[string]interface{}{
+			"name":           h.Name,
+			"description":    h.Description,
+			"interval":       h.Interval,
+			"interval_unit":  h.IntervalUnit,
+			"enabled":        h.Enabled,
+			"expired":        h.Expired,
+			"status":         heartbeatStatus,
+			"last_ping_time": h.LastPingTime,
+			"owner_team_id":  h.OwnerTeam.Id,
+			"alert_message":  h.AlertMessage,
+			"alert_priority": h.AlertPriority,
+			"alert_tags":     h.AlertTags,
+		})
+	}
+
+	d.SetId(listDataSourceId(names))
+	d.Set("names", names)
+	d.Set("heartbeats", heartbeats)
+
+	return nil
+}
+
+// opsgenieHeartbeatStatus reports a disabled heartbeat as such even if it
+// expired before it was disabled, as Opsgenie no longer alerts for it.
+func opsgenieHeartbeatStatus(enabled, expired bool) string {
+	switch {
+	case !enabled:
+		return heartbeatStatusDisabled
+	case expired:
+		return heartbeatStatusExpired
+	default:
+		return heartbeatStatusActive
+	}
+}
@@ -0,0 +1,73 @@
+package opsgenie
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestOpsgenieHeartbeatStatus(t *testing.T) {
+	cases := []struct {
+		enabled, expired bool
+		expected         string
+	}{
+		{enabled: true, expired: false, expected: heartbeatStatusActive},
+		{enabled: true, expired: true, expected: heartbeatStatusExpired},
+		{enabled: false, expired: false, expected: heartbeatStatusDisabled},
+		{enabled: false, expired: true, expected: heartbeatStatusDisabled},
+	}
+	for _, c := range cases {
+		if got := opsgenieHeartbeatStatus(c.enabled, c.expired); got != c.expected {
+			t.Errorf("opsgenieHeartbeatStatus(%t, %t) = %q, expected %q", c.enabled, c.expired, got, c.expected)
+		}
+	}
+}
+
+func TestAccDataSourceOpsgenieHeartbeats_Basic(t *testing.T) {
+	randomName := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsgenieHeartbeatsConfig(randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opsgenie_heartbeats.test", "names.#", "1"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_heartbeats.test", "names.0", "opsgenie_heartbeat.test", "name"),
+					resource.TestCheckResourceAttr("data.opsgenie_heartbeats.test", "heartbeats.0.enabled", "false"),
+					resource.TestCheckResourceAttr("data.opsgenie_heartbeats.test", "heartbeats.0.status", "disabled"),
+					resource.TestCheckResourceAttr("data.opsgenie_heartbeats.test", "heartbeats.0.interval", "10"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_heartbeats.test", "heartbeats.0.owner_team_id", "opsgenie_team.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpsgenieHeartbeatsConfig(randomName string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_team" "test" {
+  name        = "genieteam-%[1]s"
+  description = "This team deals with all the things"
+}
+
+resource "opsgenie_heartbeat" "test" {
+  name           = "genieheartbeat-%[1]s"
+  description    = "test opsgenie heartbeat terraform"
+  interval_unit  = "minutes"
+  interval       = 10
+  enabled        = false
+  alert_message  = "Test"
+  alert_priority = "P3"
+  owner_team_id  = opsgenie_team.test.id
+}
+
+data "opsgenie_heartbeats" "test" {
+  name_regex    = "^genieheartbeat-%[1]s$"
+  owner_team_id = opsgenie_team.test.id
+  depends_on    = [opsgenie_heartbeat.test]
+}
+`, randomName)
+}
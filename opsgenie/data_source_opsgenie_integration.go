@@ -0,0 +1,155 @@
+package opsgenie
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/integration"
+)
+
+func dataSourceOpsgenieIntegration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsgenieIntegrationRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"expose_api_key": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"owner_team_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"allow_write_access": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"suppress_notifications": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"responders": integrationRespondersDataSourceSchema(),
+			"api_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func integrationRespondersDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOpsgenieIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	id := d.Get("id").(string)
+	if id == "" {
+		name := d.Get("name").(string)
+
+		log.Printf("[INFO] Looking up OpsGenie integration '%s'", name)
+
+		list, err := client.List(ctx)
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+		for _, i := range list.Integrations {
+			if i.Name == name {
+				id = i.Id
+				break
+			}
+		}
+		if id == "" {
+			return diag.Diagnostics{attributeDiagnostic("name", "Integration not found", fmt.Errorf("no OpsGenie integration is named %q", name))}
+		}
+	}
+
+	log.Printf("[INFO] Reading OpsGenie integration '%s'", id)
+
+	result, err := client.Get(ctx, &integration.GetRequest{
+		Id: id,
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(id)
+	for key, value := range flattenOpsgenieIntegrationData(result.Data) {
+		d.Set(key, value)
+	}
+	if d.Get("expose_api_key").(bool) {
+		d.Set("api_key", result.Data["apiKey"])
+	} else {
+		d.Set("api_key", "")
+	}
+
+	return nil
+}
+
+// flattenOpsgenieIntegrationData maps the generic integration returned by
+// integration.Client.Get to the attributes shared by the integration data
+// sources. The API key is left out on purpose.
+func flattenOpsgenieIntegrationData(data map[string]interface{}) map[string]interface{} {
+	ownerTeamId := ""
+	if ownerTeam, ok := data["ownerTeam"].(map[string]interface{}); ok {
+		ownerTeamId, _ = ownerTeam["id"].(string)
+	}
+	responders := []map[string]interface{}{}
+	if r, ok := data["responders"].([]interface{}); ok {
+		responders = flattenIntegrationResponders(r)
+	}
+
+	return map[string]interface{}{
+		"id":                     data["id"],
+		"name":                   data["name"],
+		"type":                   data["type"],
+		"enabled":                data["enabled"],
+		"owner_team_id":          ownerTeamId,
+		"allow_write_access":     data["allowWriteAccess"],
+		"suppress_notifications": data["suppressNotifications"],
+		"responders":             responders,
+	}
+}
@@ -0,0 +1,53 @@
+package opsgenie
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOpsGenieIntegration_Basic(t *testing.T) {
+	randomTeam := acctest.RandString(6)
+	randomIntegration := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieIntegrationConfig(randomTeam, randomIntegration),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.opsgenie_integration.by_name", "id", "opsgenie_api_integration.test", "id"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_integration.by_name", "owner_team_id", "opsgenie_team.test", "id"),
+					resource.TestCheckResourceAttr("data.opsgenie_integration.by_name", "type", "API"),
+					resource.TestCheckResourceAttr("data.opsgenie_integration.by_name", "api_key", ""),
+					resource.TestCheckResourceAttrPair("data.opsgenie_integration.by_id", "name", "opsgenie_api_integration.test", "name"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_integration.by_id", "api_key", "opsgenie_api_integration.test", "api_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpsGenieIntegrationConfig(randomTeam, randomIntegration string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_team" "test" {
+  name        = "genieteam-%s"
+  description = "This team deals with all the things"
+}
+resource "opsgenie_api_integration" "test" {
+  type          = "API"
+  name          = "genieintegration-%s"
+  owner_team_id = opsgenie_team.test.id
+}
+data "opsgenie_integration" "by_name" {
+  name       = opsgenie_api_integration.test.name
+  depends_on = [opsgenie_api_integration.test]
+}
+data "opsgenie_integration" "by_id" {
+  id             = opsgenie_api_integration.test.id
+  expose_api_key = true
+}
+`, randomTeam, randomIntegration)
+}
@@ -0,0 +1,149 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/integration"
+)
+
+func dataSourceOpsgenieIntegrations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsgenieIntegrationsRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": nameRegexSchema(),
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"owner_team_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"detailed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"integrations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"owner_team_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allow_write_access": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"suppress_notifications": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"responders": integrationRespondersDataSourceSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOpsgenieIntegrationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	log.Printf("[INFO] Listing OpsGenie integrations")
+
+	// The integrations API returns every integration at once, there is nothing to paginate
+	list, err := client.List(ctx)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	matchesName := nameRegexMatcher(d)
+	integrationType := d.Get("type").(string)
+	ownerTeamId := d.Get("owner_team_id").(string)
+	// enabled is a filter only when it is set, false included
+	enabledFilter := !d.GetRawConfig().GetAttr("enabled").IsNull()
+	enabled := d.Get("enabled").(bool)
+	detailed := d.Get("detailed").(bool)
+
+	ids := make([]string, 0)
+	integrations := make([]map[string]interface{}, 0)
+	for _, i := range list.Integrations {
+		if !matchesName(i.Name) ||
+			(integrationType != "" && i.Type != integrationType) ||
+			(ownerTeamId != "" && i.TeamId != ownerTeamId) ||
+			(enabledFilter && i.Enabled != enabled) {
+			continue
+		}
+
+		ids = append(ids, i.Id)
+		if !detailed {
+			integrations = append(integrations, flattenOpsgenieIntegrationListItem(i))
+			continue
+		}
+
+		// Responders and the other details are only returned for a single
+		// integration, so they cost one request per integration
+		result, err := client.Get(ctx, &integration.GetRequest{
+			Id: i.Id,
+		})
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+		integrations = append(integrations, flattenOpsgenieIntegrationData(result.Data))
+	}
+
+	d.SetId(listDataSourceId(ids))
+	d.Set("ids", ids)
+	d.Set("integrations", integrations)
+
+	return nil
+}
+
+// flattenOpsgenieIntegrationListItem flattens the fields the list of
+// integrations returns, leaving the details empty.
+func flattenOpsgenieIntegrationListItem(i integration.GenericFields) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                     i.Id,
+		"name":                   i.Name,
+		"type":                   i.Type,
+		"enabled":                i.Enabled,
+		"owner_team_id":          i.TeamId,
+		"allow_write_access":     false,
+		"suppress_notifications": false,
+		"responders":             []map[string]interface{}{},
+	}
+}
@@ -0,0 +1,63 @@
+package opsgenie
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOpsGenieIntegrations_Basic(t *testing.T) {
+	randomTeam := acctest.RandString(6)
+	randomIntegration := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieIntegrationsConfig(randomTeam, randomIntegration),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opsgenie_integrations.by_team", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.opsgenie_integrations.disabled", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_integrations.disabled", "integrations.0.id", "opsgenie_api_integration.disabled", "id"),
+					resource.TestCheckResourceAttr("data.opsgenie_integrations.disabled", "integrations.0.enabled", "false"),
+					resource.TestCheckResourceAttr("data.opsgenie_integrations.disabled", "integrations.0.allow_write_access", "true"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_integrations.by_team", "integrations.0.owner_team_id", "opsgenie_team.test", "id"),
+					resource.TestCheckResourceAttr("data.opsgenie_integrations.by_team", "integrations.0.responders.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpsGenieIntegrationsConfig(randomTeam, randomIntegration string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_team" "test" {
+  name        = "genieteam-%[1]s"
+  description = "This team deals with all the things"
+}
+resource "opsgenie_api_integration" "enabled" {
+  type          = "API"
+  name          = "genieintegrations-%[2]s-a"
+  owner_team_id = opsgenie_team.test.id
+}
+resource "opsgenie_api_integration" "disabled" {
+  type          = "API"
+  name          = "genieintegrations-%[2]s-b"
+  owner_team_id = opsgenie_team.test.id
+  enabled       = false
+}
+data "opsgenie_integrations" "by_team" {
+  owner_team_id = opsgenie_team.test.id
+  depends_on    = [opsgenie_api_integration.enabled, opsgenie_api_integration.disabled]
+}
+data "opsgenie_integrations" "disabled" {
+  name_regex = "^genieintegrations-%[2]s-"
+  type       = "API"
+  enabled    = false
+  detailed   = true
+  depends_on = [opsgenie_api_integration.enabled, opsgenie_api_integration.disabled]
+}
+`, randomTeam, randomIntegration)
+}
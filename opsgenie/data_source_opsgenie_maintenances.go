@@ -0,0 +1,208 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/maintenance"
+)
+
+func dataSourceOpsgenieMaintenances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsgenieMaintenancesRead,
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(maintenance.NonExpired),
+				ValidateFunc: validation.StringInSlice([]string{string(maintenance.All), string(maintenance.NonExpired), string(maintenance.Past)}, false),
+			},
+			"entity_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"entity_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(maintenance.Integration), string(maintenance.Policy)}, false),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"maintenances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"in_effect": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"time": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"start_date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"end_date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"rules": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"state": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"entity": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"type": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOpsgenieMaintenancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := maintenance.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	status := d.Get("status").(string)
+	entityId := d.Get("entity_id").(string)
+	entityType := maintenance.RuleEntityType(d.Get("entity_type").(string))
+
+	log.Printf("[INFO] Listing OpsGenie maintenances with status '%s'", status)
+
+	list, err := client.List(ctx, &maintenance.ListRequest{
+		Type: maintenance.StatusType(status),
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	now := time.Now()
+	ids := make([]string, 0)
+	maintenances := make([]map[string]interface{}, 0)
+	for _, m := range list.Maintenances {
+		// Rules are only returned for a single maintenance
+		result, err := client.Get(ctx, &maintenance.GetRequest{
+			Id: m.Id,
+		})
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+		if !maintenanceHasEntity(result.Results, entityId, entityType) {
+			continue
+		}
+
+		ids = append(ids, result.Id)
+		maintenances = append(maintenances, map[string]interface{}{
+			"id":          result.Id,
+			"description": result.Description,
+			"status":      result.Status,
+			"in_effect":   maintenanceInEffect(result.Status, result.Time, now),
+			"time":        flattenMaintenanceTime(result.Time),
+			"rules":       flattenOpsgenieMaintenanceRules(result.Results),
+		})
+	}
+
+	d.SetId(listDataSourceId(ids))
+	d.Set("ids", ids)
+	d.Set("maintenances", maintenances)
+
+	return nil
+}
+
+func flattenOpsgenieMaintenanceRules(input []maintenance.Rule) []map[string]interface{} {
+	rules := make([]map[string]interface{}, 0, len(input))
+	for _, r := range input {
+		rules = append(rules, map[string]interface{}{
+			"state": string(r.State),
+			"entity": []map[string]interface{}{{
+				"id":   r.Entity.Id,
+				"type": string(r.Entity.Type),
+			}},
+		})
+	}
+	return rules
+}
+
+// maintenanceHasEntity reports whether one of the rules targets the entity.
+// An empty id or type matches any entity.
+func maintenanceHasEntity(rules []maintenance.Rule, id string, entityType maintenance.RuleEntityType) bool {
+	if id == "" && entityType == "" {
+		return true
+	}
+	for _, r := range rules {
+		if (id == "" || r.Entity.Id == id) && (entityType == "" || r.Entity.Type == entityType) {
+			return true
+		}
+	}
+	return false
+}
+
+// maintenanceInEffect reports whether a maintenance applies at the given
+// moment. OpsGenie reports running maintenances as active, but the status is
+// only refreshed periodically, so the time window is checked as well.
+func maintenanceInEffect(status string, window maintenance.Time, now time.Time) bool {
+	switch status {
+	case "active":
+		return true
+	case "cancelled", "past":
+		return false
+	}
+	if window.StartDate == nil || now.Before(*window.StartDate) {
+		return false
+	}
+	return window.EndDate == nil || now.Before(*window.EndDate)
+}
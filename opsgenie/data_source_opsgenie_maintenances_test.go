@@ -0,0 +1,84 @@
+package opsgenie
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/maintenance"
+)
+
+func TestAccDataSourceOpsGenieMaintenances_Basic(t *testing.T) {
+	randomIntegration := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieMaintenancesConfig(randomIntegration),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opsgenie_maintenances.test", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_maintenances.test", "maintenances.0.id", "opsgenie_maintenance.test", "id"),
+					resource.TestCheckResourceAttr("data.opsgenie_maintenances.test", "maintenances.0.in_effect", "false"),
+					resource.TestCheckResourceAttr("data.opsgenie_maintenances.test", "maintenances.0.rules.0.state", "disabled"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_maintenances.test", "maintenances.0.rules.0.entity.0.id", "opsgenie_api_integration.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestMaintenanceInEffect(t *testing.T) {
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	before := now.Add(-time.Hour)
+	after := now.Add(time.Hour)
+
+	cases := []struct {
+		status   string
+		window   maintenance.Time
+		expected bool
+	}{
+		{"active", maintenance.Time{}, true},
+		{"cancelled", maintenance.Time{StartDate: &before, EndDate: &after}, false},
+		{"planned", maintenance.Time{StartDate: &before, EndDate: &after}, true},
+		{"planned", maintenance.Time{StartDate: &after}, false},
+		{"planned", maintenance.Time{StartDate: &before, EndDate: &before}, false},
+		{"planned", maintenance.Time{StartDate: &before}, true},
+	}
+	for _, c := range cases {
+		if actual := maintenanceInEffect(c.status, c.window, now); actual != c.expected {
+			t.Errorf("expected %s maintenance %+v in effect to be %t, got %t", c.status, c.window, c.expected, actual)
+		}
+	}
+}
+
+func testAccDataSourceOpsGenieMaintenancesConfig(randomIntegration string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_api_integration" "test" {
+  type = "API"
+  name = "genieintegration-%s"
+}
+resource "opsgenie_maintenance" "test" {
+  description = "maintenance test"
+  time {
+    type       = "schedule"
+    start_date = "2030-01-01T00:00:00Z"
+    end_date   = "2030-01-01T01:00:00Z"
+  }
+  rules {
+    state = "disabled"
+    entity {
+      id   = opsgenie_api_integration.test.id
+      type = "integration"
+    }
+  }
+}
+data "opsgenie_maintenances" "test" {
+  entity_id   = opsgenie_api_integration.test.id
+  entity_type = "integration"
+  depends_on  = [opsgenie_maintenance.test]
+}
+`, randomIntegration)
+}
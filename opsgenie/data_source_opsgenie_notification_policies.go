@@ -0,0 +1,38 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/policy"
+)
+
+func dataSourceOpsGenieNotificationPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsGenieNotificationPoliciesRead,
+		// Notification policies always belong to a team
+		Schema: policyListDataSourceSchema(true),
+	}
+}
+
+func dataSourceOpsGenieNotificationPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := policy.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	log.Printf("[INFO] Listing OpsGenie Notification Policies of team '%s'", d.Get("team_id").(string))
+
+	result, err := client.ListNotificationPolicies(ctx, &policy.ListNotificationPoliciesRequest{
+		TeamId: d.Get("team_id").(string),
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	setOpsGeniePolicyList(d, result.Policies)
+
+	return nil
+}
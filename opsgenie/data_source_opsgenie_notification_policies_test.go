@@ -0,0 +1,36 @@
+package opsgenie
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOpsGenieNotificationPolicies_Basic(t *testing.T) {
+	randomTeam := acctest.RandString(6)
+	randomNotificationPolicyName := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieNotificationPoliciesConfig(randomTeam, randomNotificationPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opsgenie_notification_policies.test", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_notification_policies.test", "policies.0.id", "opsgenie_notification_policy.test", "id"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_notification_policies.test", "policies.0.name", "opsgenie_notification_policy.test", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpsGenieNotificationPoliciesConfig(teamName, notificationPolicyName string) string {
+	return testAccOpsGenieNotificationPolicy_basic(teamName, notificationPolicyName) + `
+data "opsgenie_notification_policies" "test" {
+  team_id    = opsgenie_team.test.id
+  depends_on = [opsgenie_notification_policy.test]
+}
+`
+}
@@ -0,0 +1,45 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/policy"
+)
+
+func dataSourceOpsGenieNotificationPolicy() *schema.Resource {
+	s := dataSourceSchemaFromResourceSchema(resourceOpsGenieNotificationPolicy().Schema)
+	s["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s["team_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceOpsGenieNotificationPolicyRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceOpsGenieNotificationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := policy.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	log.Printf("[INFO] Looking up OpsGenie Notification Policy '%s'", d.Get("name").(string))
+
+	result, err := client.ListNotificationPolicies(ctx, &policy.ListNotificationPoliciesRequest{
+		TeamId: d.Get("team_id").(string),
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	return readOpsGeniePolicyByName(ctx, d, meta, result.Policies, resourceOpsGenieNotificationPolicyRead)
+}
@@ -0,0 +1,37 @@
+package opsgenie
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOpsGenieNotificationPolicy_Basic(t *testing.T) {
+	randomTeam := acctest.RandString(6)
+	randomNotificationPolicyName := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieNotificationPolicyConfig(randomTeam, randomNotificationPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.opsgenie_notification_policy.test", "id", "opsgenie_notification_policy.test", "id"),
+					resource.TestCheckResourceAttr("data.opsgenie_notification_policy.test", "delay_action.0.delay_option", "next-time"),
+					resource.TestCheckResourceAttr("data.opsgenie_notification_policy.test", "time_restriction.0.restrictions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpsGenieNotificationPolicyConfig(teamName, notificationPolicyName string) string {
+	return testAccOpsGenieNotificationPolicy_basic(teamName, notificationPolicyName) + `
+data "opsgenie_notification_policy" "test" {
+  name       = opsgenie_notification_policy.test.name
+  team_id    = opsgenie_team.test.id
+  depends_on = [opsgenie_notification_policy.test]
+}
+`
+}
@@ -0,0 +1,256 @@
+package opsgenie
+
+import (
+	"bufio"
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/schedule"
+)
+
+func dataSourceOpsgenieScheduleIcs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsgenieScheduleIcsRead,
+		Schema: map[string]*schema.Schema{
+			"schedule_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"schedule_id", "schedule_name", "on_call_user"},
+			},
+			"schedule_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"schedule_id", "schedule_name", "on_call_user"},
+			},
+			"on_call_user": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"schedule_id", "schedule_name", "on_call_user"},
+			},
+			"output_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"summary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOpsgenieScheduleIcsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := schedule.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	// The SDK writes the export to <dir><identifier>.ics before handing back
+	// the closed file, and leaves an empty file behind when the request fails.
+	// Give it a private directory so nothing leaks into the working directory.
+	dir, err := os.MkdirTemp("", "terraform-provider-opsgenie-ics")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer os.RemoveAll(dir)
+	exportPath := dir + string(os.PathSeparator)
+
+	var id string
+	var file *os.File
+	if user := d.Get("on_call_user").(string); user != "" {
+		log.Printf("[INFO] Exporting OpsGenie on-call calendar of user '%s'", user)
+
+		id = user
+		file, err = client.ExportOnCallUser(ctx, &schedule.ExportOnCallUserRequest{
+			UserIdentifier:   user,
+			ExportedFilePath: exportPath,
+		})
+	} else {
+		// Export by id, since a schedule name is not necessarily a valid file name
+		id = d.Get("schedule_id").(string)
+		if id == "" {
+			result, err := client.Get(ctx, &schedule.GetRequest{
+				IdentifierType:  schedule.Name,
+				IdentifierValue: d.Get("schedule_name").(string),
+			})
+			if err != nil {
+				return apiErrorDiagnostics(err)
+			}
+			id = result.Schedule.Id
+		}
+
+		log.Printf("[INFO] Exporting OpsGenie calendar of schedule '%s'", id)
+
+		file, err = client.ExportSchedule(ctx, &schedule.ExportScheduleRequest{
+			IdentifierType:   schedule.Id,
+			IdentifierValue:  id,
+			ExportedFilePath: exportPath,
+		})
+	}
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	content, err := os.ReadFile(file.Name())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if outputPath := d.Get("output_path").(string); outputPath != "" {
+		if err := os.WriteFile(filepath.Clean(outputPath), content, 0644); err != nil {
+			return diag.Diagnostics{attributeDiagnostic("output_path", "Unable to write the calendar", err)}
+		}
+	}
+
+	d.SetId(id)
+	d.Set("content", string(content))
+	d.Set("events", flattenIcsEvents(parseIcsEvents(string(content))))
+
+	return nil
+}
+
+type icsEvent struct {
+	uid         string
+	summary     string
+	description string
+	start       string
+	end         string
+}
+
+// parseIcsEvents extracts the VEVENTs of an iCalendar document. It only
+// understands the few properties exported by OpsGenie, which is all that is
+// needed to list who is on call when.
+func parseIcsEvents(content string) []icsEvent {
+	events := make([]icsEvent, 0)
+	var current *icsEvent
+
+	for _, line := range unfoldIcsLines(content) {
+		name, params, value := splitIcsProperty(line)
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			current = &icsEvent{}
+		case name == "END" && value == "VEVENT" && current != nil:
+			events = append(events, *current)
+			current = nil
+		case current == nil:
+			continue
+		case name == "UID":
+			current.uid = value
+		case name == "SUMMARY":
+			current.summary = unescapeIcsText(value)
+		case name == "DESCRIPTION":
+			current.description = unescapeIcsText(value)
+		case name == "DTSTART":
+			current.start = parseIcsDate(params, value)
+		case name == "DTEND":
+			current.end = parseIcsDate(params, value)
+		}
+	}
+
+	return events
+}
+
+// unfoldIcsLines joins the continuation lines of an iCalendar document, which
+// start with a space or a tab, to the lines they continue.
+func unfoldIcsLines(content string) []string {
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(content)+1)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func splitIcsProperty(line string) (string, map[string]string, string) {
+	i := strings.Index(line, ":")
+	if i < 0 {
+		return strings.ToUpper(line), nil, ""
+	}
+	parts := strings.Split(line[:i], ";")
+	params := make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		if kv := strings.SplitN(p, "=", 2); len(kv) == 2 {
+			params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+		}
+	}
+	return strings.ToUpper(parts[0]), params, line[i+1:]
+}
+
+// parseIcsDate converts an iCalendar date or date-time to RFC 3339. Values that
+// cannot be parsed are returned as they are.
+func parseIcsDate(params map[string]string, value string) string {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t.Format(time.RFC3339)
+	}
+	location := time.UTC
+	if tzid, ok := params["TZID"]; ok {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			location = l
+		}
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, location); err == nil {
+		return t.Format(time.RFC3339)
+	}
+	if t, err := time.ParseInLocation("20060102", value, location); err == nil {
+		return t.Format(time.RFC3339)
+	}
+	return value
+}
+
+func unescapeIcsText(value string) string {
+	return strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
+
+func flattenIcsEvents(events []icsEvent) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(events))
+	for _, e := range events {
+		flattened = append(flattened, map[string]interface{}{
+			"uid":         e.uid,
+			"summary":     e.summary,
+			"description": e.description,
+			"start_date":  e.start,
+			"end_date":    e.end,
+		})
+	}
+	return flattened
+}
@@ -0,0 +1,124 @@
+package opsgenie
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceOpsGenieScheduleIcs_Basic(t *testing.T) {
+	randomUser := acctest.RandString(6)
+	randomSchedule := acctest.RandString(6)
+	randomRotation := acctest.RandString(6)
+	outputPath := filepath.Join(t.TempDir(), "schedule.ics")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieScheduleIcsConfig(randomUser, randomSchedule, randomRotation, outputPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.opsgenie_schedule_ics.schedule", "id", "opsgenie_schedule.test", "id"),
+					resource.TestCheckResourceAttrSet("data.opsgenie_schedule_ics.schedule", "events.0.start_date"),
+					resource.TestCheckResourceAttrSet("data.opsgenie_schedule_ics.user", "content"),
+					testAccCheckScheduleIcsWritten("data.opsgenie_schedule_ics.schedule", outputPath),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScheduleIcsWritten(name, outputPath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		content, err := os.ReadFile(outputPath)
+		if err != nil {
+			return err
+		}
+		if string(content) != s.RootModule().Resources[name].Primary.Attributes["content"] {
+			return fmt.Errorf("expected %s to contain the exported calendar", outputPath)
+		}
+		return nil
+	}
+}
+
+func TestParseIcsEvents(t *testing.T) {
+	content := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"UID:event-1",
+		"DTSTART:20210104T090000Z",
+		"DTEND;TZID=Europe/Rome:20210111T100000",
+		"SUMMARY:jane@opsgenie.com is on call\\, primary",
+		"DESCRIPTION:A long description that is folded",
+		"  over two lines",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:event-2",
+		"DTSTART;VALUE=DATE:20210111",
+		"DTEND:not a date",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	events := parseIcsEvents(content)
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d: %v", len(events), events)
+	}
+
+	expected := icsEvent{
+		uid:         "event-1",
+		summary:     "jane@opsgenie.com is on call, primary",
+		description: "A long description that is folded over two lines",
+		start:       "2021-01-04T09:00:00Z",
+		end:         "2021-01-11T10:00:00+01:00",
+	}
+	if events[0] != expected {
+		t.Fatalf("expected %+v, got %+v", expected, events[0])
+	}
+	if events[1].start != "2021-01-11T00:00:00Z" || events[1].end != "not a date" {
+		t.Fatalf("unexpected dates of the second event: %+v", events[1])
+	}
+}
+
+func testAccDataSourceOpsGenieScheduleIcsConfig(randomUser, randomSchedule, randomRotation, outputPath string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_user" "test" {
+  username  = "genietest-%s@opsgenie.com"
+  full_name = "Acceptance Test User"
+  role      = "User"
+}
+resource "opsgenie_schedule" "test" {
+  name        = "genieschedule-%s"
+  description = "schedule test"
+  timezone    = "Europe/Rome"
+  enabled     = true
+}
+resource "opsgenie_schedule_rotation" "test" {
+  schedule_id = opsgenie_schedule.test.id
+  name        = "test-%s"
+  start_date  = "2019-06-18T17:30:00Z"
+  type        = "weekly"
+  length      = 1
+  participant {
+    type = "user"
+    id   = opsgenie_user.test.id
+  }
+}
+data "opsgenie_schedule_ics" "schedule" {
+  schedule_name = opsgenie_schedule.test.name
+  output_path   = %q
+  depends_on    = [opsgenie_schedule_rotation.test]
+}
+data "opsgenie_schedule_ics" "user" {
+  on_call_user = opsgenie_user.test.username
+  depends_on   = [opsgenie_schedule_rotation.test]
+}
+`, randomUser, randomSchedule, randomRotation, outputPath)
+}
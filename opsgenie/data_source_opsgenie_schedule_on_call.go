@@ -0,0 +1,255 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/schedule"
+)
+
+func dataSourceOpsgenieScheduleOnCall() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsgenieScheduleOnCallRead,
+		Schema: map[string]*schema.Schema{
+			"schedule_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"schedule_id", "schedule_name"},
+			},
+			"schedule_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"schedule_id", "schedule_name"},
+			},
+			"date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDate,
+			},
+			"flat": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"on_call_recipients": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"on_call_participants": onCallParticipantsSchema(),
+			"next_on_call_recipients": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exact_next_on_call_recipients": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"next_on_call_participants":       onCallParticipantsSchema(),
+			"exact_next_on_call_participants": onCallParticipantsSchema(),
+		},
+	}
+}
+
+// onCallParticipantsSchema describes the participant tree returned by the
+// who is on call API when it is not flattened. Escalations and teams are
+// expanded one level into the participants that are on call for them.
+func onCallParticipantsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"escalation_time": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"notify_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"on_call_participants": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"escalation_time": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"notify_type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOpsgenieScheduleOnCallRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := schedule.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	identifierType := schedule.Id
+	identifier := d.Get("schedule_id").(string)
+	if identifier == "" {
+		identifierType = schedule.Name
+		identifier = d.Get("schedule_name").(string)
+	}
+
+	flat := d.Get("flat").(bool)
+	var date *time.Time
+	if v := d.Get("date").(string); v != "" {
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return diag.Diagnostics{attributeDiagnostic("date", "Invalid on-call date", err)}
+		}
+		date = &parsed
+	}
+
+	log.Printf("[INFO] Reading OpsGenie on-call participants of schedule '%s'", identifier)
+
+	onCalls, err := client.GetOnCalls(ctx, &schedule.GetOnCallsRequest{
+		ScheduleIdentifierType: identifierType,
+		ScheduleIdentifier:     identifier,
+		Flat:                   &flat,
+		Date:                   date,
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	nextOnCalls, err := client.GetNextOnCall(ctx, &schedule.GetNextOnCallsRequest{
+		ScheduleIdentifierType: identifierType,
+		ScheduleIdentifier:     identifier,
+		Flat:                   &flat,
+		Date:                   date,
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	d.SetId(onCalls.Parent.Id)
+	d.Set("schedule_id", onCalls.Parent.Id)
+	d.Set("schedule_name", onCalls.Parent.Name)
+	d.Set("enabled", onCalls.Parent.Enabled)
+
+	if flat {
+		d.Set("on_call_recipients", onCalls.OnCallRecipients)
+		d.Set("on_call_participants", nil)
+		d.Set("next_on_call_recipients", nextOnCalls.NextOncallParticipants)
+		d.Set("exact_next_on_call_recipients", nextOnCalls.ExactNextOnCallParticipants)
+		d.Set("next_on_call_participants", nil)
+		d.Set("exact_next_on_call_participants", nil)
+		return nil
+	}
+
+	d.Set("on_call_recipients", onCallUserNames(onCalls.OnCallParticipants))
+	d.Set("on_call_participants", flattenOpsgenieOnCallParticipants(onCalls.OnCallParticipants))
+	d.Set("next_on_call_recipients", onCallUserNames(nextOnCallAsParticipants(nextOnCalls.NextOnCallRecipients)))
+	d.Set("exact_next_on_call_recipients", onCallUserNames(nextOnCallAsParticipants(nextOnCalls.ExactNextOnCallRecipients)))
+	d.Set("next_on_call_participants", flattenOpsgenieOnCallParticipants(nextOnCallAsParticipants(nextOnCalls.NextOnCallRecipients)))
+	d.Set("exact_next_on_call_participants", flattenOpsgenieOnCallParticipants(nextOnCallAsParticipants(nextOnCalls.ExactNextOnCallRecipients)))
+
+	return nil
+}
+
+func nextOnCallAsParticipants(input []schedule.NextOnCallRecipients) []schedule.GetOnCallParticipant {
+	participants := make([]schedule.GetOnCallParticipant, 0, len(input))
+	for _, r := range input {
+		participants = append(participants, schedule.GetOnCallParticipant{
+			Type:               r.Type,
+			Name:               r.Name,
+			Id:                 r.Id,
+			OnCallParticipants: r.OnCallParticipants,
+		})
+	}
+	return participants
+}
+
+func flattenOpsgenieOnCallParticipants(input []schedule.GetOnCallParticipant) []map[string]interface{} {
+	participants := make([]map[string]interface{}, 0, len(input))
+	for _, p := range input {
+		nested := make([]map[string]interface{}, 0, len(p.OnCallParticipants))
+		for _, n := range p.OnCallParticipants {
+			nested = append(nested, map[string]interface{}{
+				"id":              n.Id,
+				"name":            n.Name,
+				"type":            string(n.Type),
+				"escalation_time": int(n.EscalationTime),
+				"notify_type":     string(n.NotifyType),
+			})
+		}
+		participants = append(participants, map[string]interface{}{
+			"id":                   p.Id,
+			"name":                 p.Name,
+			"type":                 string(p.Type),
+			"escalation_time":      int(p.EscalationTime),
+			"notify_type":          string(p.NotifyType),
+			"on_call_participants": nested,
+		})
+	}
+	return participants
+}
+
+// onCallUserNames collects the names of the users in a participant tree, in
+// order and without duplicates, so that the recipients are available even when
+// the participants are not requested flat.
+func onCallUserNames(input []schedule.GetOnCallParticipant) []string {
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+	add := func(participantType og.ParticipantType, name string) {
+		if participantType == og.User && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	for _, p := range input {
+		add(p.Type, p.Name)
+		for _, n := range p.OnCallParticipants {
+			add(n.Type, n.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,70 @@
+package opsgenie
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOpsGenieScheduleOnCall_Basic(t *testing.T) {
+	randomUser := acctest.RandString(6)
+	randomSchedule := acctest.RandString(6)
+	randomRotation := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieScheduleOnCallConfig(randomUser, randomSchedule, randomRotation),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.opsgenie_schedule_on_call.by_name", "schedule_id", "opsgenie_schedule.test", "id"),
+					resource.TestCheckResourceAttr("data.opsgenie_schedule_on_call.by_name", "on_call_recipients.#", "1"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_schedule_on_call.by_name", "on_call_recipients.0", "opsgenie_user.test", "username"),
+					resource.TestCheckResourceAttr("data.opsgenie_schedule_on_call.by_name", "on_call_participants.0.type", "user"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_schedule_on_call.by_name", "on_call_participants.0.id", "opsgenie_user.test", "id"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_schedule_on_call.flat", "schedule_name", "opsgenie_schedule.test", "name"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_schedule_on_call.flat", "on_call_recipients.0", "opsgenie_user.test", "username"),
+					resource.TestCheckResourceAttr("data.opsgenie_schedule_on_call.flat", "on_call_participants.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpsGenieScheduleOnCallConfig(randomUser, randomSchedule, randomRotation string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_user" "test" {
+  username  = "genietest-%s@opsgenie.com"
+  full_name = "Acceptance Test User"
+  role      = "User"
+}
+resource "opsgenie_schedule" "test" {
+  name        = "genieschedule-%s"
+  description = "schedule test"
+  timezone    = "Europe/Rome"
+  enabled     = true
+}
+resource "opsgenie_schedule_rotation" "test" {
+  schedule_id = opsgenie_schedule.test.id
+  name        = "test-%s"
+  start_date  = "2019-06-18T17:30:00Z"
+  type        = "weekly"
+  length      = 1
+  participant {
+    type = "user"
+    id   = opsgenie_user.test.id
+  }
+}
+data "opsgenie_schedule_on_call" "by_name" {
+  schedule_name = opsgenie_schedule.test.name
+  depends_on    = [opsgenie_schedule_rotation.test]
+}
+data "opsgenie_schedule_on_call" "flat" {
+  schedule_id = opsgenie_schedule.test.id
+  flat        = true
+  depends_on  = [opsgenie_schedule_rotation.test]
+}
+`, randomUser, randomSchedule, randomRotation)
+}
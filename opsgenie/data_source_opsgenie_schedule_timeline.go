@@ -0,0 +1,278 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/schedule"
+)
+
+func dataSourceOpsgenieScheduleTimeline() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsgenieScheduleTimelineRead,
+		Schema: map[string]*schema.Schema{
+			"schedule_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"schedule_id", "schedule_name"},
+			},
+			"schedule_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"schedule_id", "schedule_name"},
+			},
+			"date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDate,
+			},
+			"interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"interval_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(schedule.Weeks),
+				ValidateFunc: validation.StringInSlice([]string{string(schedule.Days), string(schedule.Weeks), string(schedule.Months)}, false),
+			},
+			"expand": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{string(schedule.Base), string(schedule.Forwarding), string(schedule.Override)}, false),
+				},
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"periods":            scheduleTimelinePeriodsSchema(),
+			"base_periods":       scheduleTimelinePeriodsSchema(),
+			"forwarding_periods": scheduleTimelinePeriodsSchema(),
+			"override_periods":   scheduleTimelinePeriodsSchema(),
+			"gaps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"coverage_percent": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func scheduleTimelinePeriodsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"rotation_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rotation_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"start_date": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"end_date": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"recipient_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"recipient_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"recipient_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOpsgenieScheduleTimelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := schedule.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	identifierType := schedule.Id
+	identifier := d.Get("schedule_id").(string)
+	if identifier == "" {
+		identifierType = schedule.Name
+		identifier = d.Get("schedule_name").(string)
+	}
+
+	request := &schedule.GetTimelineRequest{
+		IdentifierType:  identifierType,
+		IdentifierValue: identifier,
+		Interval:        d.Get("interval").(int),
+		IntervalUnit:    schedule.Unit(d.Get("interval_unit").(string)),
+	}
+	for _, expand := range d.Get("expand").(*schema.Set).List() {
+		request.Expands = append(request.Expands, schedule.ExpandType(expand.(string)))
+	}
+	if v := d.Get("date").(string); v != "" {
+		date, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return diag.Diagnostics{attributeDiagnostic("date", "Invalid timeline date", err)}
+		}
+		request.Date = &date
+	}
+
+	log.Printf("[INFO] Reading OpsGenie timeline of schedule '%s'", identifier)
+
+	timeline, err := client.GetTimeline(ctx, request)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	gaps, coverage := scheduleTimelineGaps(timeline.StartDate, timeline.EndDate, timeline.FinalTimeline)
+
+	d.SetId(timeline.ScheduleInfo.Id)
+	d.Set("schedule_id", timeline.ScheduleInfo.Id)
+	d.Set("schedule_name", timeline.ScheduleInfo.Name)
+	d.Set("start_date", timeline.StartDate.Format(time.RFC3339))
+	d.Set("end_date", timeline.EndDate.Format(time.RFC3339))
+	d.Set("periods", flattenOpsgenieScheduleTimeline(timeline.FinalTimeline))
+	d.Set("base_periods", flattenOpsgenieScheduleTimeline(timeline.BaseTimeline))
+	d.Set("forwarding_periods", flattenOpsgenieScheduleTimeline(timeline.ForwardingTimeline))
+	d.Set("override_periods", flattenOpsgenieScheduleTimeline(timeline.OverrideTimeline))
+	d.Set("gaps", flattenOpsgenieScheduleTimelineGaps(gaps))
+	d.Set("coverage_percent", coverage)
+
+	return nil
+}
+
+func flattenOpsgenieScheduleTimeline(input schedule.Timeline) []map[string]interface{} {
+	periods := make([]map[string]interface{}, 0)
+	for _, rotation := range input.Rotations {
+		for _, period := range rotation.Periods {
+			periods = append(periods, map[string]interface{}{
+				"rotation_id":    rotation.Id,
+				"rotation_name":  rotation.Name,
+				"start_date":     period.StartDate.Format(time.RFC3339),
+				"end_date":       period.EndDate.Format(time.RFC3339),
+				"type":           period.Type,
+				"recipient_id":   period.Recipient.Id,
+				"recipient_name": period.Recipient.Name,
+				"recipient_type": string(period.Recipient.Type),
+			})
+		}
+	}
+	return periods
+}
+
+func flattenOpsgenieScheduleTimelineGaps(gaps []timeWindow) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(gaps))
+	for _, gap := range gaps {
+		flattened = append(flattened, map[string]interface{}{
+			"start_date": gap.start.Format(time.RFC3339),
+			"end_date":   gap.end.Format(time.RFC3339),
+		})
+	}
+	return flattened
+}
+
+type timeWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+// scheduleTimelineGaps returns the windows between start and end in which
+// nobody is on call according to the given timeline, along with the
+// percentage of that range which is covered. Periods without a recipient do
+// not count as coverage.
+func scheduleTimelineGaps(start, end time.Time, timeline schedule.Timeline) ([]timeWindow, float64) {
+	if !end.After(start) {
+		return nil, 100
+	}
+
+	covered := make([]timeWindow, 0)
+	for _, rotation := range timeline.Rotations {
+		for _, period := range rotation.Periods {
+			if (period.Recipient.Id == "" && period.Recipient.Name == "") || period.Recipient.Type == "none" {
+				continue
+			}
+			window := timeWindow{start: period.StartDate, end: period.EndDate}
+			if window.start.Before(start) {
+				window.start = start
+			}
+			if window.end.After(end) {
+				window.end = end
+			}
+			if window.end.After(window.start) {
+				covered = append(covered, window)
+			}
+		}
+	}
+	sort.Slice(covered, func(i, j int) bool {
+		return covered[i].start.Before(covered[j].start)
+	})
+
+	gaps := make([]timeWindow, 0)
+	cursor := start
+	for _, window := range covered {
+		if window.start.After(cursor) {
+			gaps = append(gaps, timeWindow{start: cursor, end: window.start})
+		}
+		if window.end.After(cursor) {
+			cursor = window.end
+		}
+	}
+	if end.After(cursor) {
+		gaps = append(gaps, timeWindow{start: cursor, end: end})
+	}
+
+	var uncovered time.Duration
+	for _, gap := range gaps {
+		uncovered += gap.end.Sub(gap.start)
+	}
+	total := end.Sub(start)
+	coverage := float64(total-uncovered) / float64(total) * 100
+
+	return gaps, math.Round(coverage*100) / 100
+}
@@ -0,0 +1,110 @@
+package opsgenie
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/schedule"
+)
+
+func TestAccDataSourceOpsGenieScheduleTimeline_Basic(t *testing.T) {
+	randomUser := acctest.RandString(6)
+	randomSchedule := acctest.RandString(6)
+	randomRotation := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieScheduleTimelineConfig(randomUser, randomSchedule, randomRotation),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.opsgenie_schedule_timeline.test", "schedule_id", "opsgenie_schedule.test", "id"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_schedule_timeline.test", "periods.0.recipient_id", "opsgenie_user.test", "id"),
+					resource.TestCheckResourceAttr("data.opsgenie_schedule_timeline.test", "gaps.#", "0"),
+					resource.TestCheckResourceAttr("data.opsgenie_schedule_timeline.test", "coverage_percent", "100"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleTimelineGaps(t *testing.T) {
+	start := time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)
+	end := start.Add(10 * time.Hour)
+	user := og.Participant{Type: og.User, Id: "user-id", Name: "user@opsgenie.com"}
+	period := func(from, to int) schedule.Period {
+		return schedule.Period{
+			StartDate: start.Add(time.Duration(from) * time.Hour),
+			EndDate:   start.Add(time.Duration(to) * time.Hour),
+			Recipient: user,
+		}
+	}
+
+	timeline := schedule.Timeline{
+		Rotations: []schedule.TimelineRotation{
+			{Periods: []schedule.Period{period(-2, 2), period(6, 8)}},
+			{Periods: []schedule.Period{period(1, 3), {StartDate: start.Add(3 * time.Hour), EndDate: start.Add(6 * time.Hour)}}},
+		},
+	}
+
+	gaps, coverage := scheduleTimelineGaps(start, end, timeline)
+
+	expected := []timeWindow{
+		{start: start.Add(3 * time.Hour), end: start.Add(6 * time.Hour)},
+		{start: start.Add(8 * time.Hour), end: end},
+	}
+	if len(gaps) != len(expected) {
+		t.Fatalf("expected %d gaps, got %d: %v", len(expected), len(gaps), gaps)
+	}
+	for i := range expected {
+		if !gaps[i].start.Equal(expected[i].start) || !gaps[i].end.Equal(expected[i].end) {
+			t.Fatalf("expected gap %d to be %v, got %v", i, expected[i], gaps[i])
+		}
+	}
+	if coverage != 50 {
+		t.Fatalf("expected 50%% coverage, got %v", coverage)
+	}
+
+	gaps, coverage = scheduleTimelineGaps(start, end, schedule.Timeline{})
+	if len(gaps) != 1 || coverage != 0 {
+		t.Fatalf("expected a single gap and no coverage for an empty timeline, got %v and %v", gaps, coverage)
+	}
+}
+
+func testAccDataSourceOpsGenieScheduleTimelineConfig(randomUser, randomSchedule, randomRotation string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_user" "test" {
+  username  = "genietest-%s@opsgenie.com"
+  full_name = "Acceptance Test User"
+  role      = "User"
+}
+resource "opsgenie_schedule" "test" {
+  name        = "genieschedule-%s"
+  description = "schedule test"
+  timezone    = "Europe/Rome"
+  enabled     = true
+}
+resource "opsgenie_schedule_rotation" "test" {
+  schedule_id = opsgenie_schedule.test.id
+  name        = "test-%s"
+  start_date  = "2019-06-18T17:30:00Z"
+  type        = "weekly"
+  length      = 1
+  participant {
+    type = "user"
+    id   = opsgenie_user.test.id
+  }
+}
+data "opsgenie_schedule_timeline" "test" {
+  schedule_id   = opsgenie_schedule.test.id
+  interval      = 2
+  interval_unit = "weeks"
+  expand        = ["base", "override"]
+  depends_on    = [opsgenie_schedule_rotation.test]
+}
+`, randomUser, randomSchedule, randomRotation)
+}
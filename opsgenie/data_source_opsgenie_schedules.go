@@ -0,0 +1,107 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/schedule"
+)
+
+func dataSourceOpsgenieSchedules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsgenieSchedulesRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": nameRegexSchema(),
+			"owner_team_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"schedules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"owner_team_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOpsgenieSchedulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := schedule.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	log.Printf("[INFO] Listing OpsGenie schedules")
+
+	// The schedules API returns every schedule at once, there is nothing to paginate
+	expand := false
+	result, err := client.List(ctx, &schedule.ListRequest{
+		Expand: &expand,
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	matchesName := nameRegexMatcher(d)
+	ownerTeamId := d.Get("owner_team_id").(string)
+	ids := make([]string, 0)
+	schedules := make([]map[string]interface{}, 0)
+	for _, s := range result.Schedule {
+		teamId := ""
+		if s.OwnerTeam != nil {
+			teamId = s.OwnerTeam.Id
+		}
+		if !matchesName(s.Name) || (ownerTeamId != "" && teamId != ownerTeamId) {
+			continue
+		}
+		ids = append(ids, s.Id)
+		schedules = append(schedules, map[string]interface{}{
+			"id":            s.Id,
+			"name":          s.Name,
+			"description":   s.Description,
+			"timezone":      s.Timezone,
+			"enabled":       s.Enabled,
+			"owner_team_id": teamId,
+		})
+	}
+
+	d.SetId(listDataSourceId(ids))
+	d.Set("ids", ids)
+	d.Set("schedules", schedules)
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package opsgenie
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOpsGenieSchedules_Basic(t *testing.T) {
+	randomTeam := acctest.RandString(6)
+	randomSchedule := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieSchedulesConfig(randomTeam, randomSchedule),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opsgenie_schedules.by_name", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.opsgenie_schedules.by_team", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_schedules.by_team", "schedules.0.id", "opsgenie_schedule.owned", "id"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_schedules.by_team", "schedules.0.timezone", "opsgenie_schedule.owned", "timezone"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpsGenieSchedulesConfig(randomTeam, randomSchedule string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_team" "test" {
+  name        = "genieteam-%[1]s"
+  description = "This team deals with all the things"
+}
+resource "opsgenie_schedule" "owned" {
+  name          = "genieschedules-%[2]s-a"
+  description   = "schedule test"
+  timezone      = "Europe/Rome"
+  owner_team_id = opsgenie_team.test.id
+}
+resource "opsgenie_schedule" "unowned" {
+  name        = "genieschedules-%[2]s-b"
+  description = "schedule test"
+  timezone    = "Europe/Rome"
+}
+data "opsgenie_schedules" "by_name" {
+  name_regex = "^genieschedules-%[2]s-"
+  depends_on = [opsgenie_schedule.owned, opsgenie_schedule.unowned]
+}
+data "opsgenie_schedules" "by_team" {
+  name_regex    = "^genieschedules-%[2]s-"
+  owner_team_id = opsgenie_team.test.id
+  depends_on    = [opsgenie_schedule.owned, opsgenie_schedule.unowned]
+}
+`, randomTeam, randomSchedule)
+}
@@ -2,6 +2,7 @@ package opsgenie
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/service"
 	"log"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceOpsGenieService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceOpsGenieServiceRead,
+		ReadContext: dataSourceOpsGenieServiceRead,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -32,18 +33,65 @@ func dataSourceOpsGenieService() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validateOpsGenieServiceDescription,
 			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"incident_rules":     serviceIncidentRulesDataSourceSchema(),
+			"audience_template":  dataSourceAttributeFromResourceAttribute(resourceOpsGenieServiceAudienceTemplate().Schema["audience_template"]),
+			"incident_templates": serviceIncidentTemplatesDataSourceSchema(),
+		},
+	}
+}
+
+// serviceIncidentRulesDataSourceSchema reuses the incident_rule block of the
+// opsgenie_service_incident_rule resource, extended with the rule id and order.
+func serviceIncidentRulesDataSourceSchema() *schema.Schema {
+	rules := dataSourceAttributeFromResourceAttribute(resourceOpsGenieServiceIncidentRule().Schema["incident_rule"])
+	fields := rules.Elem.(*schema.Resource).Schema
+	fields["id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	fields["order"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+	return rules
+}
+
+// serviceIncidentTemplatesDataSourceSchema shares the incident_properties
+// block with the incident rules, as both are sent as the same structure.
+func serviceIncidentTemplatesDataSourceSchema() *schema.Schema {
+	rules := serviceIncidentRulesDataSourceSchema()
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"incident_properties": rules.Elem.(*schema.Resource).Schema["incident_properties"],
+			},
 		},
 	}
 }
 
-func dataSourceOpsGenieServiceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceOpsGenieServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// OpsGenie async call to create service might take a bit of time to take affect.
 	// This sleep will make sure we are not hitting 404 error if hit get/list service API before creation could happen.
 	time.Sleep(5 * time.Second)
 
 	client, err := service.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 
@@ -53,12 +101,12 @@ func dataSourceOpsGenieServiceRead(d *schema.ResourceData, meta interface{}) err
 	offset := 0
 
 	for {
-		res, err := client.List(context.Background(), &service.ListRequest{
+		res, err := client.List(ctx, &service.ListRequest{
 			Limit:  100,
 			Offset: offset,
 		})
 		if err != nil {
-			return err
+			return apiErrorDiagnostics(err)
 		}
 
 		log.Printf("[DEBUG] Searching for service name: '%s' in your account", name)
@@ -69,6 +117,7 @@ func dataSourceOpsGenieServiceRead(d *schema.ResourceData, meta interface{}) err
 				d.Set("name", srvObj.Name)
 				d.Set("team_id", srvObj.TeamId)
 				d.Set("description", srvObj.Description)
+				d.Set("tags", srvObj.Tags)
 				d.SetId(srvObj.Id)
 				breakFlag = true
 				break
@@ -84,8 +133,62 @@ func dataSourceOpsGenieServiceRead(d *schema.ResourceData, meta interface{}) err
 		offset, err = strconv.Atoi(offsetString)
 
 		if err != nil {
-			return err
+			return apiErrorDiagnostics(err)
 		}
 	}
+
+	if d.Id() == "" {
+		return nil
+	}
+	return readOpsGenieServiceDetails(ctx, d, client)
+}
+
+func readOpsGenieServiceDetails(ctx context.Context, d *schema.ResourceData, client *service.Client) diag.Diagnostics {
+	serviceId := d.Id()
+
+	log.Printf("[INFO] Reading incident rules, audience template and incident templates of OpsGenie service '%s'", serviceId)
+
+	incidentRules, err := client.GetIncidentRules(ctx, &service.GetIncidentRulesRequest{
+		ServiceId: serviceId,
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	audienceTemplate, err := client.GetAudienceTemplate(ctx, &service.GetAudienceTemplateRequest{
+		ServiceId: serviceId,
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	incidentTemplates, err := client.GetIncidentTemplates(ctx, &service.GetIncidentTemplatesRequest{
+		ServiceId: serviceId,
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	rules := make([]map[string]interface{}, 0, len(incidentRules.IncidentRule))
+	for _, v := range incidentRules.IncidentRule {
+		rule := flattenOpsGenieServiceIncidentRules(v)[0]
+		rule["id"] = v.Id
+		rule["order"] = v.Order
+		rules = append(rules, rule)
+	}
+
+	templates := make([]map[string]interface{}, 0, len(incidentTemplates.IncidentTemplates))
+	for _, v := range incidentTemplates.IncidentTemplates {
+		templates = append(templates, map[string]interface{}{
+			"id":                  v.Id,
+			"name":                v.Name,
+			"incident_properties": flattenOpsGenieServiceIncidentRuleIncidentProperties(v.IncidentProperties),
+		})
+	}
+
+	d.Set("incident_rules", rules)
+	d.Set("audience_template", flattenOpsgenieServiceAudienceTemplate(audienceTemplate))
+	d.Set("incident_templates", templates)
+
 	return nil
 }
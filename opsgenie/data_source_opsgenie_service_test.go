@@ -26,6 +26,28 @@ func TestAccDataSourceOpsGenieService_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceOpsGenieService_IncidentRules(t *testing.T) {
+	randomTeamName := acctest.RandString(6)
+	randomServiceName := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieServiceIncidentRulesConfig(randomTeamName, randomServiceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opsgenie_service.existingservice", "incident_rules.#", "1"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_service.existingservice", "incident_rules.0.id", "opsgenie_service_incident_rule.test", "id"),
+					resource.TestCheckResourceAttr("data.opsgenie_service.existingservice", "incident_rules.0.condition_match_type", "match-any-condition"),
+					resource.TestCheckResourceAttr("data.opsgenie_service.existingservice", "incident_rules.0.conditions.#", "1"),
+					resource.TestCheckResourceAttr("data.opsgenie_service.existingservice", "incident_rules.0.incident_properties.0.priority", "P3"),
+					resource.TestCheckResourceAttr("data.opsgenie_service.existingservice", "incident_rules.0.incident_properties.0.stakeholder_properties.0.message", "Message for stakeholders"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceOpsGenieService(src, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -69,3 +91,38 @@ data "opsgenie_service" "existingservice" {
 }
 `, randomTeamName, randomServiceName)
 }
+
+func testAccDataSourceOpsGenieServiceIncidentRulesConfig(randomTeamName, randomServiceName string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_team" "test" {
+  name        = "genieteam-%s"
+  description = "This team deals with all the things"
+}
+resource "opsgenie_service" "test" {
+  name    = "genieservice-%s"
+  team_id = opsgenie_team.test.id
+}
+resource "opsgenie_service_incident_rule" "test" {
+  service_id = opsgenie_service.test.id
+  incident_rule {
+    condition_match_type = "match-any-condition"
+    conditions {
+      field          = "message"
+      operation      = "contains"
+      expected_value = "expected1"
+    }
+    incident_properties {
+      message  = "This is a test message"
+      priority = "P3"
+      stakeholder_properties {
+        message = "Message for stakeholders"
+      }
+    }
+  }
+}
+data "opsgenie_service" "existingservice" {
+  name       = opsgenie_service.test.name
+  depends_on = [opsgenie_service_incident_rule.test]
+}
+`, randomTeamName, randomServiceName)
+}
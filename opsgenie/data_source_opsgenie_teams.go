@@ -0,0 +1,80 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/team"
+)
+
+func dataSourceOpsGenieTeams() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsGenieTeamsRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": nameRegexSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"teams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOpsGenieTeamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := team.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	log.Printf("[INFO] Listing OpsGenie teams")
+
+	// The teams API returns every team at once, there is nothing to paginate
+	result, err := client.List(ctx, &team.ListTeamRequest{})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	matchesName := nameRegexMatcher(d)
+	ids := make([]string, 0)
+	teams := make([]map[string]interface{}, 0)
+	for _, t := range result.Teams {
+		if !matchesName(t.Name) {
+			continue
+		}
+		ids = append(ids, t.Id)
+		teams = append(teams, map[string]interface{}{
+			"id":          t.Id,
+			"name":        t.Name,
+			"description": t.Description,
+		})
+	}
+
+	d.SetId(listDataSourceId(ids))
+	d.Set("ids", ids)
+	d.Set("teams", teams)
+
+	return nil
+}
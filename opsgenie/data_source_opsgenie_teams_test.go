@@ -0,0 +1,44 @@
+package opsgenie
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOpsGenieTeams_Basic(t *testing.T) {
+	randomTeam := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieTeamsConfig(randomTeam),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opsgenie_teams.test", "ids.#", "2"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_teams.test", "teams.0.id", "opsgenie_team.first", "id"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_teams.test", "teams.1.description", "opsgenie_team.second", "description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpsGenieTeamsConfig(randomTeam string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_team" "first" {
+  name        = "genieteams-%[1]s-a"
+  description = "This team deals with all the things"
+}
+resource "opsgenie_team" "second" {
+  name        = "genieteams-%[1]s-b"
+  description = "This team deals with the other things"
+}
+data "opsgenie_teams" "test" {
+  name_regex = "^genieteams-%[1]s-"
+  depends_on = [opsgenie_team.first, opsgenie_team.second]
+}
+`, randomTeam)
+}
@@ -0,0 +1,262 @@
+package opsgenie
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/user"
+)
+
+func dataSourceOpsGenieUserReferences() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsGenieUserReferencesRead,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"user_id", "username"},
+			},
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"user_id", "username"},
+			},
+			"teams": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"escalations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_team_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"schedules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"forwarding_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"from_user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"from_username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to_user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to_username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"has_references": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOpsGenieUserReferencesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := user.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	identifier := d.Get("user_id").(string)
+	if identifier == "" {
+		identifier = d.Get("username").(string)
+	}
+
+	usr, err := client.Get(ctx, &user.GetRequest{
+		Identifier: identifier,
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	refs, err := getOpsGenieUserReferences(ctx, client, usr.Id)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	teams := make([]map[string]interface{}, 0, len(refs.teams))
+	for _, t := range refs.teams {
+		teams = append(teams, map[string]interface{}{
+			"id":   t.Id,
+			"name": t.Name,
+		})
+	}
+	escalations := make([]map[string]interface{}, 0, len(refs.escalations))
+	for _, e := range refs.escalations {
+		escalations = append(escalations, map[string]interface{}{
+			"id":            e.Id,
+			"name":          e.Name,
+			"owner_team_id": e.OwnerTeam.Id,
+		})
+	}
+	schedules := make([]map[string]interface{}, 0, len(refs.schedules))
+	for _, s := range refs.schedules {
+		schedules = append(schedules, map[string]interface{}{
+			"id":      s.Id,
+			"name":    s.Name,
+			"enabled": s.Enabled,
+		})
+	}
+	forwardingRules := make([]map[string]interface{}, 0, len(refs.forwardingRules))
+	for _, r := range refs.forwardingRules {
+		forwardingRules = append(forwardingRules, map[string]interface{}{
+			"id":            r.Id,
+			"alias":         r.Alias,
+			"from_user_id":  r.FromUser.Id,
+			"from_username": r.FromUser.Username,
+			"to_user_id":    r.ToUser.Id,
+			"to_username":   r.ToUser.Username,
+			"start_date":    r.StartDate.Format(time.RFC3339),
+			"end_date":      r.EndDate.Format(time.RFC3339),
+		})
+	}
+
+	d.SetId(usr.Id)
+	d.Set("user_id", usr.Id)
+	d.Set("username", usr.Username)
+	d.Set("teams", teams)
+	d.Set("escalations", escalations)
+	d.Set("schedules", schedules)
+	d.Set("forwarding_rules", forwardingRules)
+	d.Set("has_references", len(refs.describe()) > 0)
+
+	return nil
+}
+
+// opsGenieUserReferences holds everything in OpsGenie that refers to a user.
+type opsGenieUserReferences struct {
+	teams           []user.Team
+	escalations     []user.UserEscalation
+	schedules       []user.Schedule
+	forwardingRules []user.ForwardingRule
+}
+
+func getOpsGenieUserReferences(ctx context.Context, client *user.Client, identifier string) (*opsGenieUserReferences, error) {
+	log.Printf("[INFO] Listing OpsGenie references of user '%s'", identifier)
+
+	teams, err := client.ListUserTeams(ctx, &user.ListUserTeamsRequest{Identifier: identifier})
+	if err != nil {
+		return nil, err
+	}
+	escalations, err := client.ListUserEscalations(ctx, &user.ListUserEscalationsRequest{Identifier: identifier})
+	if err != nil {
+		return nil, err
+	}
+	schedules, err := client.ListUserSchedules(ctx, &user.ListUserSchedulesRequest{Identifier: identifier})
+	if err != nil {
+		return nil, err
+	}
+	forwardingRules, err := client.ListUserForwardingRules(ctx, &user.ListUserForwardingRulesRequest{Identifier: identifier})
+	if err != nil {
+		return nil, err
+	}
+
+	return &opsGenieUserReferences{
+		teams:           teams.Teams,
+		escalations:     escalations.Escalations,
+		schedules:       schedules.Schedules,
+		forwardingRules: forwardingRules.ForwardingRules,
+	}, nil
+}
+
+// describe returns one line per reference, in a form suitable for an error
+// message. It returns nothing when the user is not referenced at all.
+func (r *opsGenieUserReferences) describe() []string {
+	lines := make([]string, 0)
+	for _, t := range r.teams {
+		lines = append(lines, fmt.Sprintf("team %q (%s)", t.Name, t.Id))
+	}
+	for _, e := range r.escalations {
+		lines = append(lines, fmt.Sprintf("escalation %q (%s)", e.Name, e.Id))
+	}
+	for _, s := range r.schedules {
+		lines = append(lines, fmt.Sprintf("schedule %q (%s)", s.Name, s.Id))
+	}
+	for _, f := range r.forwardingRules {
+		lines = append(lines, fmt.Sprintf("forwarding rule from %s to %s (%s)", f.FromUser.Username, f.ToUser.Username, f.Id))
+	}
+	return lines
+}
+
+func (r *opsGenieUserReferences) String() string {
+	return strings.Join(r.describe(), "\n")
+}
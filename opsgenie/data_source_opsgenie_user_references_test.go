@@ -0,0 +1,51 @@
+package opsgenie
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOpsGenieUserReferences_Basic(t *testing.T) {
+	randomUser := acctest.RandString(6)
+	randomTeam := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieUserReferencesConfig(randomUser, randomTeam),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.opsgenie_user_references.test", "user_id", "opsgenie_user.test", "id"),
+					resource.TestCheckResourceAttr("data.opsgenie_user_references.test", "teams.#", "1"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_user_references.test", "teams.0.id", "opsgenie_team.test", "id"),
+					resource.TestCheckResourceAttr("data.opsgenie_user_references.test", "has_references", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpsGenieUserReferencesConfig(randomUser, randomTeam string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_user" "test" {
+  username  = "genietest-%s@opsgenie.com"
+  full_name = "Acceptance Test User"
+  role      = "User"
+}
+resource "opsgenie_team" "test" {
+  name        = "genieteam-%s"
+  description = "This team deals with all the things"
+  member {
+    id   = opsgenie_user.test.id
+    role = "user"
+  }
+}
+data "opsgenie_user_references" "test" {
+  username   = opsgenie_user.test.username
+  depends_on = [opsgenie_team.test]
+}
+`, randomUser, randomTeam)
+}
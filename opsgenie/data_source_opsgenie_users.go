@@ -0,0 +1,147 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/user"
+)
+
+// usersPageSize is the largest page the users API returns.
+const usersPageSize = 100
+
+func dataSourceOpsGenieUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOpsGenieUsersRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": nameRegexSchema(),
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"full_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"locale": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"blocked": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"verified": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOpsGenieUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := user.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	matchesName := nameRegexMatcher(d)
+	tags := d.Get("tags").(*schema.Set)
+	ids := make([]string, 0)
+	users := make([]map[string]interface{}, 0)
+
+	for offset := 0; ; offset += usersPageSize {
+		log.Printf("[INFO] Listing OpsGenie users from offset %d", offset)
+
+		result, err := client.List(ctx, &user.ListRequest{
+			Limit:  usersPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return apiErrorDiagnostics(err)
+		}
+
+		for _, u := range result.Users {
+			if !matchesName(u.Username) || !hasAllTags(u.Tags, tags) {
+				continue
+			}
+			role := ""
+			if u.Role != nil {
+				role = u.Role.RoleName
+			}
+			ids = append(ids, u.Id)
+			users = append(users, map[string]interface{}{
+				"id":        u.Id,
+				"username":  u.Username,
+				"full_name": u.FullName,
+				"role":      role,
+				"locale":    u.Locale,
+				"timezone":  u.TimeZone,
+				"tags":      u.Tags,
+				"blocked":   u.Blocked,
+				"verified":  u.Verified,
+			})
+		}
+
+		if len(result.Users) < usersPageSize || offset+len(result.Users) >= result.TotalCount {
+			break
+		}
+	}
+
+	d.SetId(listDataSourceId(ids))
+	d.Set("ids", ids)
+	d.Set("users", users)
+
+	return nil
+}
+
+// hasAllTags reports whether every tag in wanted is one of tags.
+func hasAllTags(tags []string, wanted *schema.Set) bool {
+	has := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		has[tag] = true
+	}
+	for _, tag := range wanted.List() {
+		if !has[tag.(string)] {
+			return false
+		}
+	}
+	return true
+}
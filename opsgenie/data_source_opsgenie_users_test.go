@@ -0,0 +1,53 @@
+package opsgenie
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOpsGenieUsers_Basic(t *testing.T) {
+	randomUser := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceOpsGenieUsersConfig(randomUser),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opsgenie_users.by_name", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.opsgenie_users.by_tag", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_users.by_tag", "users.0.id", "opsgenie_user.tagged", "id"),
+					resource.TestCheckResourceAttrPair("data.opsgenie_users.by_tag", "users.0.full_name", "opsgenie_user.tagged", "full_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceOpsGenieUsersConfig(randomUser string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_user" "tagged" {
+  username  = "genieusers-%[1]s-a@opsgenie.com"
+  full_name = "Acceptance Test User"
+  role      = "User"
+  tags      = ["oncall-%[1]s"]
+}
+resource "opsgenie_user" "untagged" {
+  username  = "genieusers-%[1]s-b@opsgenie.com"
+  full_name = "Acceptance Test User"
+  role      = "User"
+}
+data "opsgenie_users" "by_name" {
+  name_regex = "^genieusers-%[1]s-"
+  depends_on = [opsgenie_user.tagged, opsgenie_user.untagged]
+}
+data "opsgenie_users" "by_tag" {
+  name_regex = "^genieusers-%[1]s-"
+  tags       = ["oncall-%[1]s"]
+  depends_on = [opsgenie_user.tagged, opsgenie_user.untagged]
+}
+`, randomUser)
+}
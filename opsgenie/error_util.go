@@ -0,0 +1,84 @@
+package opsgenie
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
+)
+
+// asApiError returns the OpsGenie ApiError wrapped in err, if there is one.
+func asApiError(err error) (*client.ApiError, bool) {
+	var apiErr *client.ApiError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// isNotFoundError reports whether err is, or wraps, an OpsGenie ApiError
+// with a 404 status code.
+func isNotFoundError(err error) bool {
+	apiErr, ok := asApiError(err)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// apiErrorDiagnostics translates an error returned by the OpsGenie SDK into
+// diagnostics. An ApiError is reported with its HTTP status, the OpsGenie
+// error message and the request ID that OpsGenie support asks for, followed
+// by one diagnostic per field validation error. Any other error is returned
+// as a single diagnostic.
+func apiErrorDiagnostics(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	apiErr, ok := asApiError(err)
+	if !ok {
+		return diag.FromErr(err)
+	}
+
+	message := apiErr.Message
+	if message == "" {
+		message = http.StatusText(apiErr.StatusCode)
+	}
+
+	diags := diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("OpsGenie API error (HTTP %d): %s", apiErr.StatusCode, message),
+			Detail:   apiErrorDetail(apiErr),
+		},
+	}
+
+	fields := make([]string, 0, len(apiErr.Errors))
+	for field := range apiErr.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Invalid value for %q: %s", field, apiErr.Errors[field]),
+			Detail:   apiErrorDetail(apiErr),
+		})
+	}
+
+	return diags
+}
+
+func apiErrorDetail(apiErr *client.ApiError) string {
+	details := []string{fmt.Sprintf("Status: %d %s", apiErr.StatusCode, http.StatusText(apiErr.StatusCode))}
+	if apiErr.ErrorHeader != "" {
+		details = append(details, fmt.Sprintf("Error type: %s", apiErr.ErrorHeader))
+	}
+	if apiErr.RequestId != "" {
+		details = append(details, fmt.Sprintf("Request ID: %s", apiErr.RequestId))
+	}
+	return strings.Join(details, "\n")
+}
@@ -0,0 +1,62 @@
+package opsgenie
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
+)
+
+func TestIsNotFoundError(t *testing.T) {
+	notFound := &client.ApiError{StatusCode: 404, Message: "Team not found"}
+
+	if !isNotFoundError(notFound) {
+		t.Fatal("expected a 404 ApiError to be a not found error")
+	}
+	if !isNotFoundError(fmt.Errorf("reading team: %w", notFound)) {
+		t.Fatal("expected a wrapped 404 ApiError to be a not found error")
+	}
+	if isNotFoundError(&client.ApiError{StatusCode: 422}) {
+		t.Fatal("expected a 422 ApiError not to be a not found error")
+	}
+	if isNotFoundError(errors.New("connection refused")) {
+		t.Fatal("expected a plain error not to be a not found error")
+	}
+}
+
+func TestApiErrorDiagnostics(t *testing.T) {
+	apiErr := &client.ApiError{
+		StatusCode: 422,
+		Message:    "Request body is not processable",
+		RequestId:  "8e4b3d6c-6f4a-4b4e-9c0a-1b2f3e4d5c6b",
+		Errors: map[string]string{
+			"name":     "Name is already in use",
+			"interval": "Must be positive",
+		},
+	}
+
+	diags := apiErrorDiagnostics(fmt.Errorf("creating heartbeat: %w", apiErr))
+	if len(diags) != 3 {
+		t.Fatalf("expected 3 diagnostics, got %d: %v", len(diags), diags)
+	}
+	if !strings.Contains(diags[0].Summary, "422") || !strings.Contains(diags[0].Summary, apiErr.Message) {
+		t.Fatalf("expected status and message in summary, got %q", diags[0].Summary)
+	}
+	if !strings.Contains(diags[0].Detail, apiErr.RequestId) {
+		t.Fatalf("expected request id in detail, got %q", diags[0].Detail)
+	}
+	if !strings.Contains(diags[1].Summary, `"interval"`) || !strings.Contains(diags[2].Summary, `"name"`) {
+		t.Fatalf("expected field errors sorted by field, got %q and %q", diags[1].Summary, diags[2].Summary)
+	}
+
+	diags = apiErrorDiagnostics(errors.New("connection refused"))
+	if len(diags) != 1 || diags[0].Summary != "connection refused" {
+		t.Fatalf("expected plain errors to be passed through, got %v", diags)
+	}
+
+	if diags := apiErrorDiagnostics(nil); diags != nil {
+		t.Fatalf("expected no diagnostics for a nil error, got %v", diags)
+	}
+}
@@ -0,0 +1,93 @@
+package opsgenie
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// frameworkProvider is the terraform-plugin-framework half of the provider.
+// It is served next to the SDKv2 provider returned by Provider() through a
+// mux server, so that resources and data sources can be migrated to the
+// framework one at a time. Its schema must stay identical to the SDKv2
+// provider schema, and it shares the same Config and OpsGenie client.
+type frameworkProvider struct{}
+
+type frameworkProviderModel struct {
+	ApiKey types.String `tfsdk:"api_key"`
+	ApiUrl types.String `tfsdk:"api_url"`
+}
+
+var _ provider.Provider = &frameworkProvider{}
+
+func NewFrameworkProvider() provider.Provider {
+	return &frameworkProvider{}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "opsgenie"
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"api_url": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := Config{
+		ApiKey: os.Getenv("OPSGENIE_API_KEY"),
+		ApiUrl: os.Getenv("OPSGENIE_API_URL"),
+	}
+	if !data.ApiKey.IsNull() {
+		config.ApiKey = data.ApiKey.ValueString()
+	}
+	if !data.ApiUrl.IsNull() {
+		config.ApiUrl = data.ApiUrl.ValueString()
+	}
+	if config.ApiUrl == "" {
+		config.ApiUrl = defaultApiUrl
+	}
+
+	if err := config.validate(); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("api_key"), "Missing OpsGenie API key", err.Error())
+		return
+	}
+
+	cli, err := config.Client()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create OpsGenie client", err.Error())
+		return
+	}
+
+	resp.DataSourceData = cli
+	resp.ResourceData = cli
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
@@ -1,13 +1,208 @@
 package opsgenie
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
+	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/integration"
 )
 
+// opsgenieIntegrationActionBlocks maps the action blocks of
+// opsgenie_integration_action to the action types of the integration actions
+// API, which are also the keys the actions are grouped by.
+var opsgenieIntegrationActionBlocks = map[string]string{
+	"create":           "create",
+	"close":            "close",
+	"acknowledge":      "acknowledge",
+	"unacknowledge":    "unacknowledge",
+	"add_note":         "addNote",
+	"snooze":           "snooze",
+	"add_tags":         "addTags",
+	"remove_tags":      "removeTags",
+	"add_details":      "addDetails",
+	"assign_ownership": "assignOwnership",
+	"ignore":           "ignore",
+}
+
+// opsgenieIntegrationActionTypes are the action types of the integration
+// actions API.
+var opsgenieIntegrationActionTypes = []string{
+	"create", "close", "acknowledge", "unacknowledge", "addNote", "snooze",
+	"addTags", "removeTags", "addDetails", "assignOwnership", "ignore",
+}
+
+// integrationAction adds the fields of the action types the SDK does not know
+// of to integration.IntegrationAction.
+type integrationAction struct {
+	integration.IntegrationAction
+	EndTime string            `json:"endTime,omitempty"`
+	Owner   string            `json:"owner,omitempty"`
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// integrationActions are the actions of an integration by action type.
+type integrationActions map[string][]integrationAction
+
+// integrationActionsRequest reads or replaces the actions of an integration.
+// The SDK requests only support five action types and reject actions without
+// an alias, which ignore actions do not have.
+type integrationActionsRequest struct {
+	client.BaseRequest
+	Id      string
+	method  string
+	Actions integrationActions
+}
+
+func (r *integrationActionsRequest) Validate() error {
+	if r.Id == "" {
+		return errors.New("Integration ID cannot be blank.")
+	}
+	return nil
+}
+
+func (r *integrationActionsRequest) ResourcePath() string {
+	return "/v2/integrations/" + r.Id + "/actions"
+}
+
+func (r *integrationActionsRequest) Method() string {
+	return r.method
+}
+
+// MarshalJSON sends every action type, as the API leaves the actions of a type
+// which is missing from the request as they are.
+func (r *integrationActionsRequest) MarshalJSON() ([]byte, error) {
+	body := make(map[string][]integrationAction, len(opsgenieIntegrationActionTypes))
+	for _, actionType := range opsgenieIntegrationActionTypes {
+		body[actionType] = r.Actions[actionType]
+		if body[actionType] == nil {
+			body[actionType] = []integrationAction{}
+		}
+	}
+	return json.Marshal(body)
+}
+
+type integrationActionsResult struct {
+	client.ResultMetadata
+	Parent  integration.ParentIntegration
+	Actions integrationActions
+}
+
+func (r *integrationActionsResult) UnmarshalJSON(b []byte) error {
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(b, &body); err != nil {
+		return err
+	}
+	if parent, ok := body["_parent"]; ok {
+		if err := json.Unmarshal(parent, &r.Parent); err != nil {
+			return err
+		}
+	}
+	r.Actions = make(integrationActions)
+	for _, actionType := range opsgenieIntegrationActionTypes {
+		raw, ok := body[actionType]
+		if !ok {
+			continue
+		}
+		var actions []integrationAction
+		if err := json.Unmarshal(raw, &actions); err != nil {
+			return err
+		}
+		r.Actions[actionType] = actions
+	}
+	return nil
+}
+
+// opsgenieEmailIntegrationFilterFields are the fields the filters of the
+// actions of email integrations match against. They refer to the incoming
+// email rather than to the alert.
+var opsgenieEmailIntegrationFilterFields = []string{"from_address", "from_name", "subject", "conversationSubject"}
+
+// opsgenieIntegrationFilterFieldWarnings warns about action filters which use
+// fields that are not matched by the type of the integration, since the API
+// accepts them but the actions never run. The filter fields of email
+// integrations differ from those of the other integration types.
+func opsgenieIntegrationFilterFieldWarnings(ctx context.Context, meta interface{}, integrationId string, actions integrationActions) diag.Diagnostics {
+	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return nil
+	}
+	result, err := client.Get(ctx, &integration.GetRequest{
+		Id: integrationId,
+	})
+	if err != nil {
+		log.Printf("[WARN] Not checking the action filter fields of OpsGenie integration '%s': %s", integrationId, err)
+		return nil
+	}
+	isEmail := result.Data["type"] == EmailIntegrationType
+
+	var diags diag.Diagnostics
+	for _, actionType := range opsgenieIntegrationActionTypes {
+		for _, action := range actions[actionType] {
+			if action.Filter == nil {
+				continue
+			}
+			for _, condition := range action.Filter.Conditions {
+				if isEmail == opsgenieIsEmailIntegrationFilterField(string(condition.Field)) {
+					continue
+				}
+				detail := fmt.Sprintf("The filter of %s action '%s' uses the field '%s', which only applies to email integrations.", actionType, action.Name, condition.Field)
+				if isEmail {
+					detail = fmt.Sprintf("The filter of %s action '%s' uses the field '%s', but the filters of email integrations match one of: %s.", actionType, action.Name, condition.Field, strings.Join(opsgenieEmailIntegrationFilterFields, ", "))
+				}
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Filter field does not apply to the integration",
+					Detail:   detail,
+				})
+			}
+		}
+	}
+	return diags
+}
+
+func opsgenieIsEmailIntegrationFilterField(field string) bool {
+	for _, f := range opsgenieEmailIntegrationFilterFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+func getOpsgenieIntegrationActions(ctx context.Context, meta interface{}, integrationId string) (*integrationActionsResult, error) {
+	result := &integrationActionsResult{}
+	err := meta.(*OpsgenieClient).client.Exec(ctx, &integrationActionsRequest{
+		Id:     integrationId,
+		method: http.MethodGet,
+	}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func putOpsgenieIntegrationActions(ctx context.Context, meta interface{}, integrationId string, actions integrationActions) (*integrationActionsResult, error) {
+	result := &integrationActionsResult{}
+	err := meta.(*OpsgenieClient).client.Exec(ctx, &integrationActionsRequest{
+		Id:      integrationId,
+		method:  http.MethodPut,
+		Actions: actions,
+	}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func expandOpsgenieIntegrationResponders(d *schema.ResourceData) []integration.Responder {
 	input := d.Get("responders").([]interface{})
 	responders := make([]integration.Responder, 0, len(input))
@@ -30,6 +225,49 @@ func expandOpsgenieIntegrationResponders(d *schema.ResourceData) []integration.R
 	return responders
 }
 
+// expandOpsgenieIntegrationRespondersWithOwnerTeam adds the owner team to the
+// configured responders, as Opsgenie routes the alerts of an integration to
+// its owner team as well.
+func expandOpsgenieIntegrationRespondersWithOwnerTeam(d *schema.ResourceData) []integration.Responder {
+	responders := expandOpsgenieIntegrationResponders(d)
+	ownerTeam := d.Get("owner_team_id").(string)
+	if ownerTeam == "" {
+		return responders
+	}
+	for _, r := range responders {
+		if strings.EqualFold(string(r.Type), "team") && r.Id == ownerTeam {
+			return responders
+		}
+	}
+	return append(responders, integration.Responder{
+		Type: integration.ResponderType("team"),
+		Id:   ownerTeam,
+	})
+}
+
+// flattenIntegrationConfiguredResponders leaves out the owner team responder
+// added by expandOpsgenieIntegrationRespondersWithOwnerTeam, unless it was
+// configured explicitly.
+func flattenIntegrationConfiguredResponders(r []interface{}, ownerTeam string, declared []interface{}) []map[string]interface{} {
+	ownerTeamDeclared := false
+	for _, v := range declared {
+		config, ok := v.(map[string]interface{})
+		if ok && strings.EqualFold(config["type"].(string), "team") && config["id"] == ownerTeam {
+			ownerTeamDeclared = true
+		}
+	}
+
+	responders := []map[string]interface{}{}
+	for _, responder := range flattenIntegrationResponders(r) {
+		responderType, _ := responder["type"].(string)
+		if ownerTeam != "" && !ownerTeamDeclared && strings.EqualFold(responderType, "team") && responder["id"] == ownerTeam {
+			continue
+		}
+		responders = append(responders, responder)
+	}
+	return responders
+}
+
 func flattenIntegrationResponders(r []interface{}) []map[string]interface{} {
 	responders := []map[string]interface{}{}
 	for _, i := range r {
@@ -42,6 +280,176 @@ func flattenIntegrationResponders(r []interface{}) []map[string]interface{} {
 	return responders
 }
 
+// integrationIgnoreRemoteChangesSchema lists the arguments of an integration
+// which are only written on create and not read back, so that they can be
+// changed outside of Terraform, e.g. by opsgenie_integration_state.
+func integrationIgnoreRemoteChangesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"enabled"}, false),
+		},
+		Set: schema.HashString,
+	}
+}
+
+func integrationIgnoresRemoteChanges(d *schema.ResourceData, attribute string) bool {
+	return d.Get("ignore_remote_changes").(*schema.Set).Contains(attribute)
+}
+
+// integrationFilterSchema is the alert filter of integration actions and of
+// the alerts an integration forwards to its endpoint.
+func integrationFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"match-all", "match-any-condition", "match-all-conditions"}, false),
+				},
+				"conditions": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"field": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"key": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"not": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+							"operation": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"expected_value": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"order": {
+								Type:     schema.TypeInt,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenOpsgenieIntegrationFieldFilter flattens a filter which is only
+// available in the untyped fields of an integration, such as the alert filter
+// of a webhook integration.
+func flattenOpsgenieIntegrationFieldFilter(input interface{}) []map[string]interface{} {
+	filter, ok := input.(map[string]interface{})
+	if !ok {
+		return []map[string]interface{}{}
+	}
+
+	conditions := make([]map[string]interface{}, 0)
+	if raw, ok := filter["conditions"].([]interface{}); ok {
+		for _, c := range raw {
+			condition, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			conditionMap := map[string]interface{}{
+				"field":          condition["field"],
+				"operation":      condition["operation"],
+				"expected_value": condition["expectedValue"],
+				"not":            condition["not"] == true,
+			}
+			if key, _ := condition["key"].(string); key != "" {
+				conditionMap["key"] = key
+			}
+			if order, ok := condition["order"].(float64); ok {
+				conditionMap["order"] = int(order)
+			}
+			conditions = append(conditions, conditionMap)
+		}
+	}
+
+	return []map[string]interface{}{
+		{
+			"type":       filter["conditionMatchType"],
+			"conditions": conditions,
+		},
+	}
+}
+
+// updateOpsgenieIntegrationFields changes fields of an integration which the
+// SDK has no typed request for. The Opsgenie Integration API does not support
+// HTTP PATCH, so the integration is read and written back as a whole with the
+// changes made by update. ForceUpdateAllFields is not used, as it would reset
+// the typed fields left empty in its request.
+func updateOpsgenieIntegrationFields(ctx context.Context, meta interface{}, id string, update func(fields map[string]interface{})) error {
+	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.Get(ctx, &integration.GetRequest{
+		Id: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	fields := result.Data
+	if readOnlyFields, found := fields["_readOnly"]; found {
+		for _, key := range readOnlyFields.([]interface{}) {
+			delete(fields, key.(string))
+		}
+	}
+	fields["id"] = id
+	update(fields)
+
+	// OtherFields.Validate expects typed responders, while the ones read back
+	// are decoded from JSON.
+	if _, ok := fields["responders"].([]integration.Responder); !ok {
+		fields["responders"] = expandOpsgenieIntegrationFieldResponders(fields["responders"])
+	}
+
+	return meta.(*OpsgenieClient).client.Exec(ctx, integration.OtherFields(fields), &integration.UpdateResult{})
+}
+
+// expandOpsgenieIntegrationFieldResponders converts the responders of an
+// integration as read into its untyped fields.
+func expandOpsgenieIntegrationFieldResponders(input interface{}) []integration.Responder {
+	responders := make([]integration.Responder, 0)
+	raw, _ := input.([]interface{})
+	for _, r := range raw {
+		responder, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		responderType, _ := responder["type"].(string)
+		id, _ := responder["id"].(string)
+		name, _ := responder["name"].(string)
+		username, _ := responder["username"].(string)
+		responders = append(responders, integration.Responder{
+			Type:     integration.ResponderType(responderType),
+			Id:       id,
+			Name:     name,
+			Username: username,
+		})
+	}
+	return responders
+}
+
 func validateResponderType(v interface{}, k string) (ws []string, errors []error) {
 	value := strings.ToLower(v.(string))
 	families := map[string]bool{
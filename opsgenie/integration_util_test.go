@@ -0,0 +1,239 @@
+package opsgenie
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/integration"
+)
+
+func TestFlattenIntegrationConfiguredResponders(t *testing.T) {
+	responders := []interface{}{
+		map[string]interface{}{"type": "user", "id": "user-id"},
+		map[string]interface{}{"type": "team", "id": "owner-id"},
+	}
+
+	expected := []map[string]interface{}{
+		{"type": "user", "id": "user-id"},
+	}
+	actual := flattenIntegrationConfiguredResponders(responders, "owner-id", nil)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected the owner team to be left out, got %#v", actual)
+	}
+
+	declared := []interface{}{
+		map[string]interface{}{"type": "team", "id": "owner-id"},
+	}
+	actual = flattenIntegrationConfiguredResponders(responders, "owner-id", declared)
+	if len(actual) != 2 {
+		t.Errorf("expected the declared owner team to be kept, got %#v", actual)
+	}
+
+	actual = flattenIntegrationConfiguredResponders(responders, "", nil)
+	if len(actual) != 2 {
+		t.Errorf("expected all responders without an owner team, got %#v", actual)
+	}
+}
+
+func TestFlattenOpsgenieIntegrationFieldFilter(t *testing.T) {
+	input := map[string]interface{}{
+		"conditionMatchType": "match-any-condition",
+		"conditions": []interface{}{
+			map[string]interface{}{
+				"field":         "extra-properties",
+				"key":           "env",
+				"not":           true,
+				"operation":     "equals",
+				"expectedValue": "prod",
+				"order":         float64(1),
+			},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"type": "match-any-condition",
+			"conditions": []map[string]interface{}{
+				{
+					"field":          "extra-properties",
+					"key":            "env",
+					"not":            true,
+					"operation":      "equals",
+					"expected_value": "prod",
+					"order":          1,
+				},
+			},
+		},
+	}
+	actual := flattenOpsgenieIntegrationFieldFilter(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %#v, got %#v", expected, actual)
+	}
+
+	if actual := flattenOpsgenieIntegrationFieldFilter(nil); len(actual) != 0 {
+		t.Errorf("expected no filter, got %#v", actual)
+	}
+}
+
+func TestIntegrationActionsRequestMarshal(t *testing.T) {
+	request := &integrationActionsRequest{
+		Id: "integration-id",
+		Actions: integrationActions{
+			"snooze": []integrationAction{
+				{
+					IntegrationAction: integration.IntegrationAction{Type: "snooze", Name: "snooze", Alias: "{{alias}}"},
+					EndTime:           "{{snooze_end_time}}",
+				},
+			},
+		},
+	}
+
+	b, err := json.Marshal(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var body map[string][]map[string]interface{}
+	if err := json.Unmarshal(b, &body); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(body) != len(opsgenieIntegrationActionTypes) {
+		t.Errorf("expected all %d action types to be sent, got %d", len(opsgenieIntegrationActionTypes), len(body))
+	}
+	if actions, ok := body["ignore"]; !ok || len(actions) != 0 {
+		t.Errorf("expected the ignore actions to be sent empty, got %#v", actions)
+	}
+	if body["snooze"][0]["endTime"] != "{{snooze_end_time}}" {
+		t.Errorf("expected the snooze end time to be sent, got %#v", body["snooze"])
+	}
+}
+
+func TestIntegrationActionsResultUnmarshal(t *testing.T) {
+	body := `{
+		"_parent": {"id": "integration-id", "name": "integration", "type": "API"},
+		"ignore": [{"type": "ignore", "name": "ignore tests", "order": 1}],
+		"addDetails": [{"type": "addDetails", "name": "add env", "alias": "{{alias}}", "details": {"env": "prod"}}],
+		"assignOwnership": [{"type": "assignOwnership", "name": "assign", "alias": "{{alias}}", "owner": "{{user}}"}]
+	}`
+
+	result := &integrationActionsResult{}
+	if err := json.Unmarshal([]byte(body), result); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if result.Parent.Id != "integration-id" {
+		t.Errorf("expected the parent integration to be read, got %#v", result.Parent)
+	}
+	if len(result.Actions["ignore"]) != 1 || result.Actions["ignore"][0].Alias != "" {
+		t.Errorf("expected an ignore action without alias, got %#v", result.Actions["ignore"])
+	}
+	if result.Actions["addDetails"][0].Details["env"] != "prod" {
+		t.Errorf("expected the details to be read, got %#v", result.Actions["addDetails"])
+	}
+	if result.Actions["assignOwnership"][0].Owner != "{{user}}" {
+		t.Errorf("expected the owner to be read, got %#v", result.Actions["assignOwnership"])
+	}
+}
+
+func TestOpsgenieIsEmailIntegrationFilterField(t *testing.T) {
+	for _, field := range []string{"from_address", "from_name", "subject", "conversationSubject"} {
+		if !opsgenieIsEmailIntegrationFilterField(field) {
+			t.Errorf("expected %q to be an email integration filter field", field)
+		}
+	}
+	for _, field := range []string{"message", "priority", "extra-properties"} {
+		if opsgenieIsEmailIntegrationFilterField(field) {
+			t.Errorf("expected %q not to be an email integration filter field", field)
+		}
+	}
+}
+
+// newTestOpsgenieClient returns a client which sends its requests to the given
+// test server.
+func newTestOpsgenieClient(t *testing.T, ts *httptest.Server) *OpsgenieClient {
+	ogClient, err := client.NewOpsGenieClient(&client.Config{
+		ApiKey:         "test",
+		OpsGenieAPIURL: client.ApiUrl(strings.TrimPrefix(ts.URL, "http://")),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &OpsgenieClient{client: ogClient}
+}
+
+const testIntegrationGetBody = `{
+  "data": {
+    "id": "integration-id",
+    "name": "webhook",
+    "type": "Webhook",
+    "enabled": true,
+    "url": "https://example.com/v1",
+    "apiKey": "secret",
+    "responders": [
+      {"type": "team", "id": "team-id", "name": "team"},
+      {"type": "user", "id": "user-id", "username": "user@example.com"}
+    ],
+    "_readOnly": ["id", "apiKey"]
+  },
+  "took": 0.1,
+  "requestId": "request-id"
+}`
+
+func TestUpdateOpsgenieIntegrationFields(t *testing.T) {
+	var put map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(testIntegrationGetBody))
+		case http.MethodPut:
+			body, _ := ioutil.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &put); err != nil {
+				t.Errorf("unexpected body %s: %s", body, err)
+			}
+			w.Write([]byte(`{"data": {}, "took": 0.1, "requestId": "request-id"}`))
+		}
+	}))
+	defer ts.Close()
+
+	err := updateOpsgenieIntegrationFields(context.Background(), newTestOpsgenieClient(t, ts), "integration-id", func(fields map[string]interface{}) {
+		fields["sendAlertActions"] = true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if put["sendAlertActions"] != true || put["name"] != "webhook" {
+		t.Errorf("expected the changed fields to be sent along with the others, got %#v", put)
+	}
+	if _, ok := put["apiKey"]; ok {
+		t.Errorf("expected read only fields to be left out, got %#v", put)
+	}
+	responders, _ := put["responders"].([]interface{})
+	if len(responders) != 2 || responders[1].(map[string]interface{})["username"] != "user@example.com" {
+		t.Errorf("expected the responders to be kept, got %#v", put["responders"])
+	}
+}
+
+func TestExpandOpsgenieIntegrationFieldResponders(t *testing.T) {
+	if actual := expandOpsgenieIntegrationFieldResponders(nil); actual == nil || len(actual) != 0 {
+		t.Errorf("expected missing responders to become an empty list, got %#v", actual)
+	}
+
+	input := []interface{}{
+		map[string]interface{}{"type": "team", "id": "team-id", "name": "team"},
+	}
+	expected := []integration.Responder{
+		{Type: integration.Team, Id: "team-id", Name: "team"},
+	}
+	if actual := expandOpsgenieIntegrationFieldResponders(input); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %#v, got %#v", expected, actual)
+	}
+}
@@ -0,0 +1,48 @@
+package opsgenie
+
+import (
+	"log"
+	"sync"
+)
+
+// mutexKV holds one mutex per key, so that resources writing to the same
+// OpsGenie object in parallel can be serialized without blocking the others.
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
+
+func (m *mutexKV) Lock(key string) {
+	log.Printf("[DEBUG] Locking %q", key)
+	m.get(key).Lock()
+	log.Printf("[DEBUG] Locked %q", key)
+}
+
+func (m *mutexKV) Unlock(key string) {
+	log.Printf("[DEBUG] Unlocking %q", key)
+	m.get(key).Unlock()
+	log.Printf("[DEBUG] Unlocked %q", key)
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
+
+// integrationActionsMutexKV serializes the updates of the actions of an
+// integration, keyed by integration ID. The actions of an integration can only
+// be replaced as a whole, so concurrent read-modify-write cycles would
+// otherwise drop each other's changes.
+var integrationActionsMutexKV = newMutexKV()
@@ -0,0 +1,33 @@
+package opsgenie
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestMutexKV(t *testing.T) {
+	m := newMutexKV()
+	counter := 0
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Lock("integration-id")
+			defer m.Unlock("integration-id")
+			counter++
+		}()
+	}
+	wg.Wait()
+
+	if counter != 50 {
+		t.Errorf("expected 50 increments, got %d", counter)
+	}
+
+	// Another key must not wait for a held lock.
+	m.Lock("integration-id")
+	m.Lock("other-id")
+	m.Unlock("other-id")
+	m.Unlock("integration-id")
+}
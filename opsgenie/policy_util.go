@@ -0,0 +1,105 @@
+package opsgenie
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/policy"
+)
+
+// policyListDataSourceSchema is the schema shared by the alert and
+// notification policy list data sources.
+func policyListDataSourceSchema(teamRequired bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"team_id": {
+			Type:     schema.TypeString,
+			Optional: !teamRequired,
+			Required: teamRequired,
+		},
+		"name_regex": nameRegexSchema(),
+		"ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"policies": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"enabled": {
+						Type:     schema.TypeBool,
+						Computed: true,
+					},
+					"order": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func setOpsGeniePolicyList(d *schema.ResourceData, policies []policy.PolicyProps) {
+	matchesName := nameRegexMatcher(d)
+	ids := make([]string, 0)
+	flattened := make([]map[string]interface{}, 0)
+	for _, p := range policies {
+		if !matchesName(p.Name) {
+			continue
+		}
+		ids = append(ids, p.Id)
+		flattened = append(flattened, map[string]interface{}{
+			"id":      p.Id,
+			"name":    p.Name,
+			"type":    p.Type,
+			"enabled": p.Enabled,
+			"order":   p.Order,
+		})
+	}
+
+	d.SetId(listDataSourceId(append([]string{d.Get("team_id").(string)}, ids...)))
+	d.Set("ids", ids)
+	d.Set("policies", flattened)
+}
+
+// readOpsGeniePolicyByName looks up the policy named by the name argument of a
+// singular policy data source and populates it with the Read function of the
+// matching resource.
+func readOpsGeniePolicyByName(ctx context.Context, d *schema.ResourceData, meta interface{}, policies []policy.PolicyProps, read schema.ReadContextFunc) diag.Diagnostics {
+	name := d.Get("name").(string)
+	id := ""
+	for _, p := range policies {
+		if p.Name == name {
+			id = p.Id
+			break
+		}
+	}
+	if id == "" {
+		return diag.Diagnostics{attributeDiagnostic("name", "Policy not found", fmt.Errorf("no OpsGenie policy is named %q", name))}
+	}
+
+	d.SetId(id)
+	if diags := read(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+	if d.Id() == "" {
+		return diag.Diagnostics{attributeDiagnostic("name", "Policy not found", fmt.Errorf("OpsGenie policy %q (%s) was deleted while it was read", name, id))}
+	}
+	return nil
+}
@@ -12,48 +12,70 @@ func Provider() *schema.Provider {
 
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
+			// api_key is optional, rather than required with a DefaultFunc, so
+			// that the schema matches the one of the framework provider
 			"api_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("OPSGENIE_API_KEY", nil),
 			},
 			"api_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("OPSGENIE_API_URL", "api.opsgenie.com"),
+				DefaultFunc: schema.EnvDefaultFunc("OPSGENIE_API_URL", defaultApiUrl),
 			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"opsgenie_custom_role":               resourceOpsGenieCustomUserRole(),
-			"opsgenie_team":                      resourceOpsGenieTeam(),
-			"opsgenie_team_routing_rule":         resourceOpsGenieTeamRoutingRule(),
-			"opsgenie_user":                      resourceOpsGenieUser(),
-			"opsgenie_user_contact":              resourceOpsGenieUserContact(),
-			"opsgenie_notification_policy":       resourceOpsGenieNotificationPolicy(),
-			"opsgenie_notification_rule":         resourceOpsGenieNotificationRule(),
-			"opsgenie_escalation":                resourceOpsgenieEscalation(),
-			"opsgenie_api_integration":           resourceOpsgenieApiIntegration(),
-			"opsgenie_email_integration":         resourceOpsgenieEmailIntegration(),
-			"opsgenie_integration_action":        resourceOpsgenieIntegrationAction(),
-			"opsgenie_service":                   resourceOpsGenieService(),
-			"opsgenie_schedule":                  resourceOpsgenieSchedule(),
-			"opsgenie_schedule_rotation":         resourceOpsgenieScheduleRotation(),
-			"opsgenie_maintenance":               resourceOpsgenieMaintenance(),
-			"opsgenie_heartbeat":                 resourceOpsgenieHeartbeat(),
-			"opsgenie_alert_policy":              resourceOpsGenieAlertPolicy(),
-			"opsgenie_service_incident_rule":     resourceOpsGenieServiceIncidentRule(),
-			"opsgenie_service_audience_template": resourceOpsGenieServiceAudienceTemplate(),
-			"opsgenie_incident_template":         resourceOpsgenieIncidentTemplate(),
+			"opsgenie_custom_role":                 resourceOpsGenieCustomUserRole(),
+			"opsgenie_team":                        resourceOpsGenieTeam(),
+			"opsgenie_team_routing_rule":           resourceOpsGenieTeamRoutingRule(),
+			"opsgenie_user":                        resourceOpsGenieUser(),
+			"opsgenie_user_contact":                resourceOpsGenieUserContact(),
+			"opsgenie_notification_policy":         resourceOpsGenieNotificationPolicy(),
+			"opsgenie_notification_rule":           resourceOpsGenieNotificationRule(),
+			"opsgenie_escalation":                  resourceOpsgenieEscalation(),
+			"opsgenie_api_integration":             resourceOpsgenieApiIntegration(),
+			"opsgenie_email_integration":           resourceOpsgenieEmailIntegration(),
+			"opsgenie_integration_action":          resourceOpsgenieIntegrationAction(),
+			"opsgenie_integration_outgoing_action": resourceOpsgenieIntegrationOutgoingAction(),
+			"opsgenie_integration_single_action":   resourceOpsgenieIntegrationSingleAction(),
+			"opsgenie_service":                     resourceOpsGenieService(),
+			"opsgenie_schedule":                    resourceOpsgenieSchedule(),
+			"opsgenie_schedule_rotation":           resourceOpsgenieScheduleRotation(),
+			"opsgenie_maintenance":                 resourceOpsgenieMaintenance(),
+			"opsgenie_heartbeat":                   resourceOpsgenieHeartbeat(),
+			"opsgenie_alert_policy":                resourceOpsGenieAlertPolicy(),
+			"opsgenie_service_incident_rule":       resourceOpsGenieServiceIncidentRule(),
+			"opsgenie_service_audience_template":   resourceOpsGenieServiceAudienceTemplate(),
+			"opsgenie_incident_template":           resourceOpsgenieIncidentTemplate(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"opsgenie_team":       dataSourceOpsGenieTeam(),
-			"opsgenie_user":       dataSourceOpsGenieUser(),
-			"opsgenie_escalation": dataSourceOpsgenieEscalation(),
-			"opsgenie_schedule":   dataSourceOpsgenieSchedule(),
-			"opsgenie_heartbeat":  dataSourceOpsgenieHeartbeat(),
-			"opsgenie_service":    dataSourceOpsGenieService(),
+			"opsgenie_team":                  dataSourceOpsGenieTeam(),
+			"opsgenie_user":                  dataSourceOpsGenieUser(),
+			"opsgenie_escalation":            dataSourceOpsgenieEscalation(),
+			"opsgenie_schedule":              dataSourceOpsgenieSchedule(),
+			"opsgenie_heartbeat":             dataSourceOpsgenieHeartbeat(),
+			"opsgenie_heartbeats":            dataSourceOpsgenieHeartbeats(),
+			"opsgenie_custom_role":           dataSourceOpsgenieCustomRole(),
+			"opsgenie_service":               dataSourceOpsGenieService(),
+			"opsgenie_schedule_on_call":      dataSourceOpsgenieScheduleOnCall(),
+			"opsgenie_schedule_timeline":     dataSourceOpsgenieScheduleTimeline(),
+			"opsgenie_schedule_ics":          dataSourceOpsgenieScheduleIcs(),
+			"opsgenie_teams":                 dataSourceOpsGenieTeams(),
+			"opsgenie_users":                 dataSourceOpsGenieUsers(),
+			"opsgenie_schedules":             dataSourceOpsgenieSchedules(),
+			"opsgenie_escalations":           dataSourceOpsgenieEscalations(),
+			"opsgenie_integration":           dataSourceOpsgenieIntegration(),
+			"opsgenie_integrations":          dataSourceOpsgenieIntegrations(),
+			"opsgenie_user_references":       dataSourceOpsGenieUserReferences(),
+			"opsgenie_alert_policy":          dataSourceOpsGenieAlertPolicy(),
+			"opsgenie_alert_policies":        dataSourceOpsGenieAlertPolicies(),
+			"opsgenie_notification_policy":   dataSourceOpsGenieNotificationPolicy(),
+			"opsgenie_notification_policies": dataSourceOpsGenieNotificationPolicies(),
+			"opsgenie_maintenances":          dataSourceOpsgenieMaintenances(),
 		},
 	}
 	p.ConfigureContextFunc = providerConfigure
@@ -69,6 +91,10 @@ func providerConfigure(ctx context.Context, data *schema.ResourceData) (interfac
 		ApiKey: data.Get("api_key").(string),
 		ApiUrl: data.Get("api_url").(string),
 	}
+	if err := config.validate(); err != nil {
+		return nil, diag.Diagnostics{attributeDiagnostic("api_key", "Missing OpsGenie API key", err)}
+	}
+
 	cli, err := config.Client()
 	if err != nil {
 		return nil, diag.FromErr(err)
@@ -1,14 +1,30 @@
 package opsgenie
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var testAccProvider *schema.Provider
 var providerFactories map[string]func() (*schema.Provider, error)
 
+// protoV6ProviderFactories serves the muxed provider, as main.go does. Use it
+// for acceptance tests of resources migrated to terraform-plugin-framework,
+// together with a step using ExternalProviders to check that state written by
+// the last SDKv2 release is still read back without changes.
+var protoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"opsgenie": func() (tfprotov6.ProviderServer, error) {
+		serverFactory, err := ProtoV6ProviderServerFactory(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		return serverFactory(), nil
+	},
+}
+
 func init() {
 	testAccProvider = Provider()
 	providerFactories = map[string]func() (*schema.Provider, error){
@@ -27,3 +43,23 @@ func TestProvider(t *testing.T) {
 func TestProvider_impl(t *testing.T) {
 	var _ = Provider()
 }
+
+func TestProtoV6ProviderServer(t *testing.T) {
+	server, err := protoV6ProviderFactories["opsgenie"]()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("unexpected error diagnostic: %s: %s", d.Summary, d.Detail)
+		}
+	}
+	if _, ok := resp.ResourceSchemas["opsgenie_team"]; !ok {
+		t.Fatal("expected the SDKv2 resources to be served through the mux server")
+	}
+}
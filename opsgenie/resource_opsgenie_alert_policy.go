@@ -7,13 +7,13 @@ import (
 	"github.com/opsgenie/opsgenie-go-sdk-v2/alert"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
 	"strconv"
+	"time"
 
 	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	ogClient "github.com/opsgenie/opsgenie-go-sdk-v2/client"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/policy"
 )
 
@@ -21,8 +21,8 @@ func resourceOpsGenieAlertPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceOpsGenieAlertPolicyCreate,
 		ReadContext:   resourceOpsGenieAlertPolicyRead,
-		Update:        resourceOpsGenieAlertPolicyUpdate,
-		Delete:        resourceOpsGenieAlertPolicyDelete,
+		UpdateContext: resourceOpsGenieAlertPolicyUpdate,
+		DeleteContext: resourceOpsGenieAlertPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), "/")
@@ -38,6 +38,12 @@ func resourceOpsGenieAlertPolicy() *schema.Resource {
 				}
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -285,7 +291,7 @@ func resourceOpsGenieAlertPolicy() *schema.Resource {
 func resourceOpsGenieAlertPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := policy.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	message := d.Get("message").(string)
@@ -322,20 +328,20 @@ func resourceOpsGenieAlertPolicyCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	log.Printf("[INFO] Creating Alert Policy '%s'", d.Get("name").(string))
-	result, err := client.CreateAlertPolicy(context.Background(), createRequest)
+	result, err := client.CreateAlertPolicy(ctx, createRequest)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(result.Id)
 
-	return resourceOpsGenieAlertPolicyRead(ctx, d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsGenieAlertPolicyRead)
 }
 
 func resourceOpsGenieAlertPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := policy.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return diag.FromErr(err)
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 
@@ -343,22 +349,17 @@ func resourceOpsGenieAlertPolicyRead(ctx context.Context, d *schema.ResourceData
 
 	policyRes := &policy.GetAlertPolicyResult{}
 	if d.Get("team_id").(string) == "" {
-		policyRes, err = client.GetAlertPolicy(context.Background(), &policy.GetAlertPolicyRequest{
+		policyRes, err = client.GetAlertPolicy(ctx, &policy.GetAlertPolicyRequest{
 			Id: d.Id(),
 		})
 	} else {
-		policyRes, err = client.GetAlertPolicy(context.Background(), &policy.GetAlertPolicyRequest{
+		policyRes, err = client.GetAlertPolicy(ctx, &policy.GetAlertPolicyRequest{
 			Id:     d.Id(),
 			TeamId: d.Get("team_id").(string),
 		})
 	}
 	if err != nil {
-		x := err.(*ogClient.ApiError)
-		if x.StatusCode == 404 {
-			log.Printf("[WARN] Removing Alert Policy because it's gone %s", name)
-			d.SetId("")
-			return nil
-		}
+		return handleNonExistentResource(d, err)
 	}
 	d.Set("name", policyRes.Name)
 	d.Set("enabled", policyRes.Enabled)
@@ -400,10 +401,10 @@ func resourceOpsGenieAlertPolicyRead(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
-func resourceOpsGenieAlertPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieAlertPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := policy.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	message := d.Get("message").(string)
@@ -441,19 +442,19 @@ func resourceOpsGenieAlertPolicyUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	log.Printf("[INFO] Updating Alert Policy '%s'", d.Get("name").(string))
-	_, err = client.UpdateAlertPolicy(context.Background(), updateRequest)
+	_, err = client.UpdateAlertPolicy(ctx, updateRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
 }
 
-func resourceOpsGenieAlertPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieAlertPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting OpsGenie Alert Policy '%s'", d.Get("name").(string))
 	client, err := policy.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	deleteRequest := &policy.DeletePolicyRequest{}
@@ -471,9 +472,9 @@ func resourceOpsGenieAlertPolicyDelete(d *schema.ResourceData, meta interface{})
 
 	}
 
-	_, err = client.DeletePolicy(context.Background(), deleteRequest)
+	_, err = client.DeletePolicy(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	return nil
 }
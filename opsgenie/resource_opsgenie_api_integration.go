@@ -2,8 +2,12 @@ package opsgenie
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/integration"
@@ -12,12 +16,19 @@ import (
 
 func resourceOpsgenieApiIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsgenieApiIntegrationCreate,
-		Read:   handleNonExistentResource(resourceOpsgenieApiIntegrationRead),
-		Update: resourceOpsgenieApiIntegrationUpdate,
-		Delete: resourceOpsgenieApiIntegrationDelete,
+		CreateContext: resourceOpsgenieApiIntegrationCreate,
+		ReadContext:   resourceOpsgenieApiIntegrationRead,
+		UpdateContext: resourceOpsgenieApiIntegrationUpdate,
+		DeleteContext: resourceOpsgenieApiIntegrationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customizeOpsgenieApiIntegrationDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -39,6 +50,7 @@ func resourceOpsgenieApiIntegration() *schema.Resource {
 				Type:     schema.TypeString,
 				ForceNew: true,
 				Optional: true,
+				Computed: true,
 			},
 			"ignore_responders_from_payload": {
 				Type:     schema.TypeBool,
@@ -57,6 +69,18 @@ func resourceOpsgenieApiIntegration() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"rotate_api_key_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"api_key_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"api_key_rotated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"webhook_url": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -78,23 +102,187 @@ func resourceOpsgenieApiIntegration() *schema.Resource {
 					},
 				},
 			},
+			"effective_responders": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"headers": {
-				Type:     schema.TypeMap,
-				Optional: true,
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"add_alert_description": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"add_alert_details": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"alert_filter":          opsgenieApiIntegrationAlertFilterSchema(),
+			"ignore_remote_changes": integrationIgnoreRemoteChangesSchema(),
 		},
 	}
 }
 
-func resourceOpsgenieApiIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
+// opsgenieApiIntegrationAlertFilterSchema is computed, so that a filter set
+// outside of this resource is kept as long as none is configured.
+func opsgenieApiIntegrationAlertFilterSchema() *schema.Schema {
+	s := integrationFilterSchema()
+	s.MaxItems = 1
+	s.Computed = true
+	return s
+}
+
+func resourceOpsgenieApiIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id, apiKey, diags := createOpsgenieApiIntegration(ctx, d, meta, d.Get("name").(string))
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id)
+	setOpsgenieApiIntegrationKey(d, apiKey, 1)
+
+	if diags := applyOpsgenieApiIntegration(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+
+	return readAfterCreate(ctx, d, meta, resourceOpsgenieApiIntegrationRead)
+}
+
+func createOpsgenieApiIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}, name string) (string, string, diag.Diagnostics) {
 	integrationType := d.Get("type").(string)
 	if integrationType == WebhookIntegrationType {
-		return createWebhookIntegration(d, meta)
+		return createWebhookIntegration(ctx, d, meta, name)
+	}
+	return createApiIntegration(ctx, d, meta, name)
+}
+
+func setOpsgenieApiIntegrationKey(d *schema.ResourceData, apiKey string, version int) {
+	d.Set("api_key", apiKey)
+	d.Set("api_key_version", version)
+	d.Set("api_key_rotated_at", time.Now().UTC().Format(time.RFC3339))
+}
+
+func customizeOpsgenieApiIntegrationDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("rotate_api_key_trigger") {
+		return nil
 	}
-	return createApiIntegration(d, meta)
+	for _, key := range []string{"api_key", "api_key_version", "api_key_rotated_at"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// opsgenieApiIntegrationRotatedFields are the fields which resources other
+// than opsgenie_api_integration manage on the integration itself, and which are
+// copied over when the API key is rotated.
+var opsgenieApiIntegrationRotatedFields = []string{"sendAlertActions", "alertActions", "alertFilter"}
+
+// rotateOpsgenieApiIntegrationKey replaces the integration with a new one,
+// since Opsgenie has no API to regenerate the key of an integration. The
+// integration actions and outgoing actions are moved to the new integration
+// before the old one is deleted. The new integration is created under a
+// temporary name, as the old one still holds the configured name until it is
+// deleted. If the old integration cannot be deleted, the temporary name is
+// returned along with a warning.
+func rotateOpsgenieApiIntegrationKey(ctx context.Context, d *schema.ResourceData, meta interface{}) (string, diag.Diagnostics) {
+	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return "", apiErrorDiagnostics(err)
+	}
+	oldId := d.Id()
+
+	log.Printf("[INFO] Rotating API key of OpsGenie api integration '%s'", oldId)
+
+	integrationActionsMutexKV.Lock(oldId)
+	defer integrationActionsMutexKV.Unlock(oldId)
+
+	old, err := client.Get(ctx, &integration.GetRequest{
+		Id: oldId,
+	})
+	if err != nil {
+		return "", apiErrorDiagnostics(err)
+	}
+	actions, err := getOpsgenieIntegrationActions(ctx, meta, oldId)
+	if err != nil {
+		return "", apiErrorDiagnostics(err)
+	}
+
+	temporaryName := fmt.Sprintf("%.200s (rotated %d)", d.Get("name").(string), time.Now().Unix())
+	newId, apiKey, diags := createOpsgenieApiIntegration(ctx, d, meta, temporaryName)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	integrationActionsMutexKV.Lock(newId)
+	defer integrationActionsMutexKV.Unlock(newId)
+
+	err = updateOpsgenieIntegrationFields(ctx, meta, newId, func(fields map[string]interface{}) {
+		for _, key := range opsgenieApiIntegrationRotatedFields {
+			if v, ok := old.Data[key]; ok {
+				fields[key] = v
+			}
+		}
+	})
+	if err == nil {
+		_, err = putOpsgenieIntegrationActions(ctx, meta, newId, actions.Actions)
+	}
+	if err != nil {
+		log.Printf("[WARN] Removing new OpsGenie api integration '%s' after failing to move the integration actions", newId)
+		if _, deleteErr := client.Delete(ctx, &integration.DeleteIntegrationRequest{Id: newId}); deleteErr != nil {
+			log.Printf("[ERROR] Failed to remove new OpsGenie api integration '%s': %s", newId, deleteErr)
+		}
+		return "", apiErrorDiagnostics(err)
+	}
+
+	// State follows the new integration from here on, even if the old one
+	// cannot be deleted, so that the new one is not left behind untracked.
+	oldVersion, _ := d.GetChange("api_key_version")
+	d.SetId(newId)
+	setOpsgenieApiIntegrationKey(d, apiKey, nextOpsgenieApiIntegrationKeyVersion(oldVersion.(int)))
+
+	_, err = client.Delete(ctx, &integration.DeleteIntegrationRequest{
+		Id: oldId,
+	})
+	if err != nil && !isNotFoundError(err) {
+		return temporaryName, diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Failed to delete the integration holding the previous API key",
+			Detail:   fmt.Sprintf("The API key was rotated to integration %s, but integration %s could not be deleted and still accepts the previous key. Delete it manually: %s", newId, oldId, err),
+		}}
+	}
+
+	return "", nil
+}
+
+// nextOpsgenieApiIntegrationKeyVersion returns the version of a rotated key.
+// Integrations created before keys were versioned have version 0, which is
+// unknown rather than the first key, so their first rotation yields 2.
+func nextOpsgenieApiIntegrationKeyVersion(version int) int {
+	if version < 1 {
+		return 2
+	}
+	return version + 1
 }
 
 func expandOpsGenieWebhookHeaders(d *schema.ResourceData) map[string]string {
@@ -112,18 +300,16 @@ func expandOpsGenieWebhookHeaders(d *schema.ResourceData) map[string]string {
 	return output
 }
 
-func createApiIntegration(d *schema.ResourceData, meta interface{}) error {
+func createApiIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}, name string) (string, string, diag.Diagnostics) {
 	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return "", "", apiErrorDiagnostics(err)
 	}
-	name := d.Get("name").(string)
 	allowWriteAccess := d.Get("allow_write_access").(bool)
 	ignoreRespondersFromPayload := d.Get("ignore_responders_from_payload").(bool)
 	suppressNotifications := d.Get("suppress_notifications").(bool)
 	ownerTeam := d.Get("owner_team_id").(string)
 	integrationType := d.Get("type").(string)
-	enabled := d.Get("enabled").(bool)
 
 	if integrationType == "" {
 		integrationType = ApiIntegrationType
@@ -135,55 +321,37 @@ func createApiIntegration(d *schema.ResourceData, meta interface{}) error {
 		AllowWriteAccess:            &allowWriteAccess,
 		IgnoreRespondersFromPayload: &ignoreRespondersFromPayload,
 		SuppressNotifications:       &suppressNotifications,
-		Responders:                  expandOpsgenieIntegrationResponders(d),
+		Responders:                  expandOpsgenieIntegrationRespondersWithOwnerTeam(d),
 	}
 
 	if ownerTeam != "" {
 		createRequest.OwnerTeam = &og.OwnerTeam{
 			Id: ownerTeam,
 		}
-		createRequest.Responders = append(createRequest.Responders, integration.Responder{
-			Type: integration.ResponderType("team"),
-			Id:   ownerTeam,
-		})
 	}
 
 	log.Printf("[INFO] Creating OpsGenie api integration '%s'", name)
 
-	result, err := client.CreateApiBased(context.Background(), createRequest)
+	result, err := client.CreateApiBased(ctx, createRequest)
 	if err != nil {
-		return err
+		return "", "", apiErrorDiagnostics(err)
 	}
 
-	d.SetId(result.Id)
-	d.Set("api_key", result.ApiKey)
-
-	if enabled {
-		_, err = client.Enable(context.Background(), &integration.EnableIntegrationRequest{
-			Id: result.Id,
-		})
-		if err != nil {
-			return err
-		}
-		log.Printf("[INFO] Enabled OpsGenie api integration '%s'", name)
-
-	}
-
-	return resourceOpsgenieApiIntegrationRead(d, meta)
+	return result.Id, result.ApiKey, nil
 }
 
-func createWebhookIntegration(d *schema.ResourceData, meta interface{}) error {
+func createWebhookIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}, name string) (string, string, diag.Diagnostics) {
 	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return "", "", apiErrorDiagnostics(err)
 	}
-	name := d.Get("name").(string)
 	allowWriteAccess := d.Get("allow_write_access").(bool)
 	suppressNotifications := d.Get("suppress_notifications").(bool)
 	ownerTeam := d.Get("owner_team_id").(string)
 	integrationType := d.Get("type").(string)
 	webhookUrl := d.Get("webhook_url").(string)
-	enabled := d.Get("enabled").(bool)
+	addAlertDescription := d.Get("add_alert_description").(bool)
+	addAlertDetails := d.Get("add_alert_details").(bool)
 	headers := expandOpsGenieWebhookHeaders(d)
 
 	createRequest := &integration.WebhookIntegrationRequest{
@@ -191,8 +359,10 @@ func createWebhookIntegration(d *schema.ResourceData, meta interface{}) error {
 		Type:                  integrationType,
 		AllowWriteAccess:      &allowWriteAccess,
 		SuppressNotifications: &suppressNotifications,
-		Responders:            expandOpsgenieIntegrationResponders(d),
+		Responders:            expandOpsgenieIntegrationRespondersWithOwnerTeam(d),
 		WebhookUrl:            webhookUrl,
+		AddAlertDescription:   &addAlertDescription,
+		AddAlertDetails:       &addAlertDetails,
 		Headers:               headers,
 	}
 
@@ -204,135 +374,155 @@ func createWebhookIntegration(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] Creating OpsGenie Webhook integration '%s'", name)
 
-	result, err := client.CreateWebhook(context.Background(), createRequest)
+	result, err := client.CreateWebhook(ctx, createRequest)
 	if err != nil {
-		return err
+		return "", "", apiErrorDiagnostics(err)
 	}
 
-	d.SetId(result.Id)
-	d.Set("api_key", result.ApiKey)
-
-	if enabled {
-		_, err = client.Enable(context.Background(), &integration.EnableIntegrationRequest{
-			Id: result.Id,
-		})
-		if err != nil {
-			return err
-		}
-		log.Printf("[INFO] Enabled OpsGenie Webhook integration '%s'", name)
-	}
-
-	return resourceOpsgenieApiIntegrationRead(d, meta)
+	return result.Id, result.ApiKey, nil
 }
 
-func resourceOpsgenieApiIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieApiIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
-	result, err := client.Get(context.Background(), &integration.GetRequest{
+	result, err := client.Get(ctx, &integration.GetRequest{
 		Id: d.Id(),
 	})
 	if err != nil {
-		return err
+		return handleNonExistentResource(d, err)
 	}
 
-	if result.Data["ownerTeam"] != nil {
-		ownerTeam := result.Data["ownerTeam"].(map[string]interface{})
-		d.Set("owner_team_id", ownerTeam["id"])
-	} else if result.Data["responders"] != nil {
-		d.Set("responders", flattenIntegrationResponders(result.Data["responders"].([]interface{})))
+	ownerTeamId := ""
+	if ownerTeam, ok := result.Data["ownerTeam"].(map[string]interface{}); ok {
+		ownerTeamId, _ = ownerTeam["id"].(string)
 	}
+	responders, _ := result.Data["responders"].([]interface{})
+
 	d.Set("name", result.Data["name"])
 	d.Set("type", result.Data["type"])
 	d.Set("allow_write_access", result.Data["allowWriteAccess"])
-	d.Set("enabled", result.Data["enabled"])
+	if !integrationIgnoresRemoteChanges(d, "enabled") {
+		d.Set("enabled", result.Data["enabled"])
+	}
+	d.Set("ignore_responders_from_payload", result.Data["ignoreRespondersFromPayload"])
 	d.Set("suppress_notifications", result.Data["suppressNotifications"])
-
-	if result.Data["url"] != nil {
-		d.Set("webhook_url", result.Data["url"])
+	d.Set("owner_team_id", ownerTeamId)
+	d.Set("responders", flattenIntegrationConfiguredResponders(responders, ownerTeamId, d.Get("responders").([]interface{})))
+	d.Set("effective_responders", flattenIntegrationResponders(responders))
+	d.Set("webhook_url", result.Data["url"])
+	d.Set("headers", result.Data["headers"])
+	if v, ok := result.Data["addAlertDescription"].(bool); ok {
+		d.Set("add_alert_description", v)
 	}
-
-	if result.Data["headers"] != nil {
-		d.Set("headers", result.Data["headers"])
+	if v, ok := result.Data["addAlertDetails"].(bool); ok {
+		d.Set("add_alert_details", v)
+	}
+	d.Set("alert_filter", flattenOpsgenieIntegrationFieldFilter(result.Data["alertFilter"]))
+	if apiKey, ok := result.Data["apiKey"].(string); ok && apiKey != "" {
+		d.Set("api_key", apiKey)
 	}
 
 	return nil
 }
 
-func resourceOpsgenieApiIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
-	if err != nil {
-		return err
+func resourceOpsgenieApiIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var temporaryName string
+	if d.HasChange("rotate_api_key_trigger") {
+		temporaryName, diags = rotateOpsgenieApiIntegrationKey(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
 	}
 
-	// GET+PUT workaround since the Opsgenie Integration API does not support HTTP PATCH method
-	result, err := client.Get(context.Background(), &integration.GetRequest{
-		Id: d.Id(),
-	})
-	if err != nil {
-		log.Printf("Error occurred while performing GET for integration: %s", d.Id())
-		return err
+	if applyDiags := applyOpsgenieApiIntegration(ctx, d, meta); applyDiags.HasError() {
+		if temporaryName == "" {
+			return append(diags, applyDiags...)
+		}
+		// The integration holding the previous key was not deleted and still
+		// holds the configured name, so the new one cannot take it over yet.
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Rotated integration still uses its temporary name",
+			Detail:   fmt.Sprintf("Integration %s could not be renamed to %q and still uses the temporary name %q, as the integration holding the previous API key was not deleted. Delete that integration and apply again: %s", d.Id(), d.Get("name").(string), temporaryName, opsgenieDiagnosticsSummary(applyDiags)),
+		})
 	}
 
-	userProperties := result.Data
-	userProperties["allowWriteAccess"] = d.Get("allow_write_access")
+	return append(diags, resourceOpsgenieApiIntegrationRead(ctx, d, meta)...)
+}
 
-	if readOnlyFields, found := userProperties["_readOnly"]; found {
-		for _, key := range readOnlyFields.([]interface{}) {
-			delete(userProperties, key.(string))
-		}
+// opsgenieDiagnosticsSummary joins the summaries of diags into one line.
+func opsgenieDiagnosticsSummary(diags diag.Diagnostics) string {
+	summaries := make([]string, 0, len(diags))
+	for _, d := range diags {
+		summaries = append(summaries, d.Summary)
 	}
+	return strings.Join(summaries, "; ")
+}
 
+// applyOpsgenieApiIntegration writes the whole configuration to the
+// integration. It runs after create as well, so that settings which the
+// create requests of the API and Webhook types do not take are applied the
+// same way for both.
+func applyOpsgenieApiIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	name := d.Get("name").(string)
 	integrationType := d.Get("type").(string)
-	webhookUrl := d.Get("webhook_url").(string)
-	ignoreRespondersFromPayload := d.Get("ignore_responders_from_payload").(bool)
-	suppressNotifications := d.Get("suppress_notifications").(bool)
-	enabled := d.Get("enabled").(bool)
-	headers := expandOpsGenieWebhookHeaders(d)
-
 	if integrationType == "" {
 		integrationType = ApiIntegrationType
 	}
 
-	updateRequest := &integration.UpdateIntegrationRequest{
-		Id:                          d.Id(),
-		Name:                        name,
-		Type:                        integrationType,
-		IgnoreRespondersFromPayload: &ignoreRespondersFromPayload,
-		SuppressNotifications:       &suppressNotifications,
-		Responders:                  expandOpsgenieIntegrationResponders(d),
-		Enabled:                     &enabled,
-		OtherFields:                 userProperties,
-		WebhookUrl:                  webhookUrl,
-		Headers:                     headers,
-	}
-
 	log.Printf("[INFO] Updating OpsGenie api based integration '%s'", name)
 
-	_, err = client.ForceUpdateAllFields(context.Background(), updateRequest)
+	err := retryNotFoundAfterCreate(ctx, d, func() error {
+		return updateOpsgenieIntegrationFields(ctx, meta, d.Id(), func(fields map[string]interface{}) {
+			fields["name"] = name
+			fields["type"] = integrationType
+			if d.IsNewResource() || !integrationIgnoresRemoteChanges(d, "enabled") {
+				fields["enabled"] = d.Get("enabled").(bool)
+			}
+			fields["allowWriteAccess"] = d.Get("allow_write_access").(bool)
+			fields["ignoreRespondersFromPayload"] = d.Get("ignore_responders_from_payload").(bool)
+			fields["suppressNotifications"] = d.Get("suppress_notifications").(bool)
+			fields["responders"] = expandOpsgenieIntegrationRespondersWithOwnerTeam(d)
+			if ownerTeam := d.Get("owner_team_id").(string); ownerTeam != "" {
+				fields["ownerTeam"] = map[string]interface{}{"id": ownerTeam}
+			} else {
+				delete(fields, "ownerTeam")
+			}
+			if alertFilter := d.Get("alert_filter").([]interface{}); len(alertFilter) > 0 {
+				fields["alertFilter"] = expandOpsgenieFilter(alertFilter)
+			}
+			if integrationType == WebhookIntegrationType {
+				fields["url"] = d.Get("webhook_url").(string)
+				fields["headers"] = expandOpsGenieWebhookHeaders(d)
+				fields["addAlertDescription"] = d.Get("add_alert_description").(bool)
+				fields["addAlertDetails"] = d.Get("add_alert_details").(bool)
+			}
+		})
+	})
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
 }
 
-func resourceOpsgenieApiIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieApiIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting OpsGenie api integration '%s'", d.Get("name").(string))
 	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	deleteRequest := &integration.DeleteIntegrationRequest{
 		Id: d.Id(),
 	}
 
-	_, err = client.Delete(context.Background(), deleteRequest)
+	_, err = client.Delete(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
@@ -2,13 +2,19 @@ package opsgenie
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	ogClient "github.com/opsgenie/opsgenie-go-sdk-v2/client"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/integration"
@@ -73,6 +79,165 @@ func TestAccOpsGenieApiIntegration_basic(t *testing.T) {
 	})
 }
 
+func TestAccOpsGenieApiIntegration_rotateApiKey(t *testing.T) {
+	rs := acctest.RandString(6)
+	var firstApiKey string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testCheckOpsGenieApiIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsGenieApiIntegration_rotateApiKey(rs, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOpsGenieApiIntegrationExists("opsgenie_api_integration.test"),
+					resource.TestCheckResourceAttr("opsgenie_api_integration.test", "api_key_version", "1"),
+					resource.TestCheckResourceAttrSet("opsgenie_api_integration.test", "api_key_rotated_at"),
+					func(s *terraform.State) error {
+						firstApiKey = s.RootModule().Resources["opsgenie_api_integration.test"].Primary.Attributes["api_key"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccOpsGenieApiIntegration_rotateApiKey(rs, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOpsGenieApiIntegrationExists("opsgenie_api_integration.test"),
+					resource.TestCheckResourceAttr("opsgenie_api_integration.test", "api_key_version", "2"),
+					resource.TestCheckResourceAttr("opsgenie_api_integration.test", "name", "genieintegration-"+rs),
+					func(s *terraform.State) error {
+						apiKey := s.RootModule().Resources["opsgenie_api_integration.test"].Primary.Attributes["api_key"]
+						if apiKey == "" || apiKey == firstApiKey {
+							return fmt.Errorf("expected the api key to be rotated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestNextOpsgenieApiIntegrationKeyVersion(t *testing.T) {
+	for version, expected := range map[int]int{0: 2, 1: 2, 2: 3} {
+		if actual := nextOpsgenieApiIntegrationKeyVersion(version); actual != expected {
+			t.Errorf("expected version %d to be followed by %d, got %d", version, expected, actual)
+		}
+	}
+}
+
+// testRotationServer fakes the requests of an API key rotation of integration
+// old-id to new-id and records the bodies of the PUT requests by path.
+type testRotationServer struct {
+	*httptest.Server
+	puts            map[string]map[string]interface{}
+	deleteStatus    int
+	putStatusByPath map[string]int
+}
+
+func newTestRotationServer(t *testing.T) *testRotationServer {
+	rs := &testRotationServer{
+		puts:            map[string]map[string]interface{}{},
+		deleteStatus:    http.StatusAccepted,
+		putStatusByPath: map[string]int{},
+	}
+	integrationBody := func(id, name, extra string) string {
+		return fmt.Sprintf(`{"data": {"id": %q, "name": %q, "type": "API", "enabled": true,
+  "responders": [{"type": "team", "id": "team-id", "name": "team"}]%s,
+  "_readOnly": ["id"]}, "took": 0.1, "requestId": "request-id"}`, id, name, extra)
+	}
+	rs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		status, body := http.StatusOK, `{"data": {}, "took": 0.1, "requestId": "request-id"}`
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/integrations/old-id":
+			body = integrationBody("old-id", "genieintegration", `, "sendAlertActions": true, "alertActions": ["Create"],
+  "alertFilter": {"conditionMatchType": "match-any-condition", "conditions": [{"field": "priority", "operation": "equals", "expectedValue": "P1"}]}`)
+		case "GET /v2/integrations/new-id":
+			body = integrationBody("new-id", "genieintegration (rotated 1)", "")
+		case "GET /v2/integrations/old-id/actions":
+			body = `{"data": {"close": [{"name": "close", "type": "close", "order": 1, "alias": "{{alias}}"}]}, "took": 0.1, "requestId": "request-id"}`
+		case "POST /v2/integrations":
+			status, body = http.StatusCreated, `{"data": {"id": "new-id", "name": "genieintegration (rotated 1)", "apiKey": "new-key"}, "took": 0.1, "requestId": "request-id"}`
+		case "DELETE /v2/integrations/old-id":
+			status = rs.deleteStatus
+			if status >= 400 {
+				body = `{"message": "cannot delete", "took": 0.1, "requestId": "request-id"}`
+			}
+		case "PUT /v2/integrations/new-id", "PUT /v2/integrations/new-id/actions":
+			put := map[string]interface{}{}
+			raw, _ := ioutil.ReadAll(r.Body)
+			if err := json.Unmarshal(raw, &put); err != nil {
+				t.Errorf("unexpected body %s: %s", raw, err)
+			}
+			rs.puts[r.URL.Path] = put
+			if s, ok := rs.putStatusByPath[r.URL.Path]; ok && put["name"] == "genieintegration" {
+				status, body = s, `{"message": "name already in use", "took": 0.1, "requestId": "request-id"}`
+			}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			status = http.StatusNotFound
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	return rs
+}
+
+func testRotationResourceData(t *testing.T) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, resourceOpsgenieApiIntegration().Schema, map[string]interface{}{
+		"name":                   "genieintegration",
+		"type":                   "API",
+		"rotate_api_key_trigger": "second",
+	})
+	d.SetId("old-id")
+	return d
+}
+
+func TestRotateOpsgenieApiIntegrationKey(t *testing.T) {
+	ts := newTestRotationServer(t)
+	defer ts.Close()
+	d := testRotationResourceData(t)
+
+	temporaryName, diags := rotateOpsgenieApiIntegrationKey(context.Background(), d, newTestOpsgenieClient(t, ts.Server))
+	if len(diags) > 0 || temporaryName != "" {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if d.Id() != "new-id" || d.Get("api_key").(string) != "new-key" || d.Get("api_key_version").(int) != 2 {
+		t.Errorf("expected state to follow the new integration, got id %q, version %d", d.Id(), d.Get("api_key_version").(int))
+	}
+	fields := ts.puts["/v2/integrations/new-id"]
+	if fields["sendAlertActions"] != true || fields["alertFilter"] == nil || len(fields["alertActions"].([]interface{})) != 1 {
+		t.Errorf("expected the outgoing settings to be copied, got %#v", fields)
+	}
+	if len(fields["responders"].([]interface{})) != 1 {
+		t.Errorf("expected the responders of the new integration to be kept, got %#v", fields["responders"])
+	}
+	if _, ok := ts.puts["/v2/integrations/new-id/actions"]["close"]; !ok {
+		t.Errorf("expected the actions to be moved, got %#v", ts.puts["/v2/integrations/new-id/actions"])
+	}
+}
+
+func TestRotateOpsgenieApiIntegrationKey_oldIntegrationKept(t *testing.T) {
+	ts := newTestRotationServer(t)
+	defer ts.Close()
+	ts.deleteStatus = http.StatusBadRequest
+	ts.putStatusByPath["/v2/integrations/new-id"] = http.StatusConflict
+	d := testRotationResourceData(t)
+
+	diags := resourceOpsgenieApiIntegrationUpdate(context.Background(), d, newTestOpsgenieClient(t, ts.Server))
+	if diags.HasError() {
+		t.Fatalf("expected only warnings, got %v", diags)
+	}
+	if len(diags) != 2 || diags[0].Severity != diag.Warning || !strings.Contains(diags[1].Detail, "temporary name") {
+		t.Errorf("expected warnings about the old integration and the temporary name, got %v", diags)
+	}
+	if d.Id() != "new-id" {
+		t.Errorf("expected state to follow the new integration, got %q", d.Id())
+	}
+}
+
 func TestAccOpsGenieApiIntegration_limits(t *testing.T) {
 	randomLongName := acctest.RandString(245)
 	// include a backtick here as it's not possible to escape it in the multiline string
@@ -115,6 +280,16 @@ func TestAccOpsGenieApiIntegration_complete(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testCheckOpsGenieApiIntegrationExists("opsgenie_api_integration.test"),
 					testCheckOpsGenieApiIntegrationExists("opsgenie_api_integration.test3"),
+					resource.TestCheckResourceAttr("opsgenie_api_integration.test", "responders.#", "4"),
+					resource.TestCheckResourceAttr("opsgenie_api_integration.test", "effective_responders.#", "5"),
+					resource.TestCheckResourceAttr("opsgenie_api_integration.test", "ignore_responders_from_payload", "true"),
+					resource.TestCheckResourceAttr("opsgenie_api_integration.test3", "responders.#", "0"),
+					resource.TestCheckResourceAttr("opsgenie_api_integration.test3", "effective_responders.#", "1"),
+					resource.TestCheckResourceAttrPair("opsgenie_api_integration.test3", "effective_responders.0.id", "opsgenie_team.test", "id"),
+					resource.TestCheckResourceAttr("opsgenie_api_integration.test3", "add_alert_description", "true"),
+					resource.TestCheckResourceAttr("opsgenie_api_integration.test3", "add_alert_details", "false"),
+					resource.TestCheckResourceAttr("opsgenie_api_integration.test3", "alert_filter.0.type", "match-any-condition"),
+					resource.TestCheckResourceAttr("opsgenie_api_integration.test3", "alert_filter.0.conditions.#", "1"),
 				),
 			},
 		},
@@ -183,6 +358,16 @@ resource "opsgenie_api_integration" "test" {
 `, rString)
 }
 
+func testAccOpsGenieApiIntegration_rotateApiKey(rString, trigger string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_api_integration" "test" {
+  type                   = "API"
+  name                   = "genieintegration-%s"
+  rotate_api_key_trigger = "%s"
+}
+`, rString, trigger)
+}
+
 func testAccOpsGenieApiIntegration_limits(randomLongName, randomName string) string {
 	return fmt.Sprintf(`
 resource "opsgenie_api_integration" "test_length" {
@@ -271,9 +456,18 @@ resource "opsgenie_api_integration" "test3" {
   	allow_write_access             = false
   	suppress_notifications         = false
   	webhook_url                    = "https://example.com/v1"
+  	add_alert_details              = false
   	headers = {
 		header = "value1"
 	}
+	alert_filter {
+		type = "match-any-condition"
+		conditions {
+			field          = "priority"
+			operation      = "equals"
+			expected_value = "P1"
+		}
+	}
 }
 `, randomUsername, randomTeam, randomTeam2, randomSchedule, randomEscalation, randomIntegration, randomIntegration2, randomIntegration3)
 }
@@ -3,9 +3,12 @@ package opsgenie
 import (
 	"context"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/integration"
@@ -13,12 +16,19 @@ import (
 
 func resourceOpsgenieEmailIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsgenieEmailIntegrationCreate,
-		Read:   handleNonExistentResource(resourceOpsgenieEmailIntegrationRead),
-		Update: resourceOpsgenieEmailIntegrationUpdate,
-		Delete: resourceOpsgenieEmailIntegrationDelete,
+		CreateContext: resourceOpsgenieEmailIntegrationCreate,
+		ReadContext:   resourceOpsgenieEmailIntegrationRead,
+		UpdateContext: resourceOpsgenieEmailIntegrationUpdate,
+		DeleteContext: resourceOpsgenieEmailIntegrationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customizeOpsgenieEmailIntegrationDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -30,6 +40,10 @@ func resourceOpsgenieEmailIntegration() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"email_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -64,20 +78,36 @@ func resourceOpsgenieEmailIntegration() *schema.Resource {
 					},
 				},
 			},
+			"ignore_remote_changes": integrationIgnoreRemoteChangesSchema(),
+			"effective_responders": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
-func resourceOpsgenieEmailIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieEmailIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 	emailUsername := d.Get("email_username").(string)
 	ignoreRespondersFromPayload := d.Get("ignore_responders_from_payload").(bool)
 	suppressNotifications := d.Get("suppress_notifications").(bool)
-	enabled := d.Get("enabled").(bool)
 	ownerTeam := d.Get("owner_team_id").(string)
 
 	createRequest := &integration.EmailBasedIntegrationRequest{
@@ -86,7 +116,7 @@ func resourceOpsgenieEmailIntegrationCreate(d *schema.ResourceData, meta interfa
 		EmailUsername:               emailUsername,
 		IgnoreRespondersFromPayload: &ignoreRespondersFromPayload,
 		SuppressNotifications:       &suppressNotifications,
-		Responders:                  expandOpsgenieIntegrationResponders(d),
+		Responders:                  expandOpsgenieIntegrationRespondersWithOwnerTeam(d),
 	}
 
 	if ownerTeam != "" {
@@ -97,98 +127,133 @@ func resourceOpsgenieEmailIntegrationCreate(d *schema.ResourceData, meta interfa
 
 	log.Printf("[INFO] Creating OpsGenie email integration '%s'", name)
 
-	result, err := client.CreateEmailBased(context.Background(), createRequest)
+	result, err := client.CreateEmailBased(ctx, createRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(result.Id)
+	d.Set("email_address", result.EmailAddress)
 
-	if enabled {
-		_, err = client.Enable(context.Background(), &integration.EnableIntegrationRequest{
-			Id: result.Id,
-		})
-		if err != nil {
-			return err
-		}
-		log.Printf("[INFO] Enabled OpsGenie email integration '%s'", name)
-
+	if diags := applyOpsgenieEmailIntegration(ctx, d, meta); diags.HasError() {
+		return diags
 	}
 
-	return resourceOpsgenieEmailIntegrationRead(d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsgenieEmailIntegrationRead)
 }
 
-func resourceOpsgenieEmailIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieEmailIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
-	result, err := client.Get(context.Background(), &integration.GetRequest{
+	result, err := client.Get(ctx, &integration.GetRequest{
 		Id: d.Id(),
 	})
 	if err != nil {
-		return err
+		return handleNonExistentResource(d, err)
 	}
 
-	if result.Data["ownerTeam"] != nil {
-		ownerTeam := result.Data["ownerTeam"].(map[string]interface{})
-		d.Set("owner_team_id", ownerTeam["id"])
-	} else if result.Data["responders"] != nil {
-		d.Set("responders", flattenIntegrationResponders(result.Data["responders"].([]interface{})))
+	ownerTeamId := ""
+	if ownerTeam, ok := result.Data["ownerTeam"].(map[string]interface{}); ok {
+		ownerTeamId, _ = ownerTeam["id"].(string)
 	}
+	responders, _ := result.Data["responders"].([]interface{})
+
 	d.Set("name", result.Data["name"])
-	d.Set("suppress_notifications", result.Data["suppressNotifications"])
 	d.Set("email_username", result.Data["emailUsername"])
-	d.Set("enabled", result.Data["enabled"])
+	if !integrationIgnoresRemoteChanges(d, "enabled") {
+		d.Set("enabled", result.Data["enabled"])
+	}
+	d.Set("ignore_responders_from_payload", result.Data["ignoreRespondersFromPayload"])
+	d.Set("suppress_notifications", result.Data["suppressNotifications"])
+	d.Set("owner_team_id", ownerTeamId)
+	d.Set("responders", flattenIntegrationConfiguredResponders(responders, ownerTeamId, d.Get("responders").([]interface{})))
+	d.Set("effective_responders", flattenIntegrationResponders(responders))
+	if emailAddress, ok := result.Data["emailAddress"].(string); ok && emailAddress != "" {
+		d.Set("email_address", emailAddress)
+	}
+
 	return nil
 }
 
-func resourceOpsgenieEmailIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
-	if err != nil {
-		return err
+func resourceOpsgenieEmailIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("email_username") {
+		oldAddress, _ := d.GetChange("email_address")
+		d.Set("email_address", opsgenieEmailIntegrationAddress(oldAddress.(string), d.Get("email_username").(string)))
 	}
-	name := d.Get("name").(string)
-	emailUsername := d.Get("email_username").(string)
-	ignoreRespondersFromPayload := d.Get("ignore_responders_from_payload").(bool)
-	suppressNotifications := d.Get("suppress_notifications").(bool)
-	enabled := d.Get("enabled").(bool)
 
-	updateRequest := &integration.UpdateIntegrationRequest{
-		Id:                          d.Id(),
-		Name:                        name,
-		Type:                        EmailIntegrationType,
-		EmailUsername:               emailUsername,
-		IgnoreRespondersFromPayload: &ignoreRespondersFromPayload,
-		SuppressNotifications:       &suppressNotifications,
-		Responders:                  expandOpsgenieIntegrationResponders(d),
-		Enabled:                     &enabled,
+	if diags := applyOpsgenieEmailIntegration(ctx, d, meta); diags.HasError() {
+		return diags
 	}
 
+	return resourceOpsgenieEmailIntegrationRead(ctx, d, meta)
+}
+
+// applyOpsgenieEmailIntegration writes the whole configuration to the
+// integration. It runs after create as well, as the create request does not
+// take all settings.
+func applyOpsgenieEmailIntegration(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+
 	log.Printf("[INFO] Updating OpsGenie email based integration '%s'", name)
 
-	_, err = client.ForceUpdateAllFields(context.Background(), updateRequest)
+	err := retryNotFoundAfterCreate(ctx, d, func() error {
+		return updateOpsgenieIntegrationFields(ctx, meta, d.Id(), func(fields map[string]interface{}) {
+			fields["name"] = name
+			fields["type"] = EmailIntegrationType
+			fields["emailUsername"] = d.Get("email_username").(string)
+			if d.IsNewResource() || !integrationIgnoresRemoteChanges(d, "enabled") {
+				fields["enabled"] = d.Get("enabled").(bool)
+			}
+			fields["ignoreRespondersFromPayload"] = d.Get("ignore_responders_from_payload").(bool)
+			fields["suppressNotifications"] = d.Get("suppress_notifications").(bool)
+			fields["responders"] = expandOpsgenieIntegrationRespondersWithOwnerTeam(d)
+			if ownerTeam := d.Get("owner_team_id").(string); ownerTeam != "" {
+				fields["ownerTeam"] = map[string]interface{}{"id": ownerTeam}
+			} else {
+				delete(fields, "ownerTeam")
+			}
+		})
+	})
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
 }
 
-func resourceOpsgenieEmailIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
+func customizeOpsgenieEmailIntegrationDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("email_username") {
+		return d.SetNewComputed("email_address")
+	}
+	return nil
+}
+
+// opsgenieEmailIntegrationAddress replaces the username of an email address,
+// for the case that Opsgenie does not return the address of an integration.
+func opsgenieEmailIntegrationAddress(address, username string) string {
+	at := strings.LastIndex(address, "@")
+	if at < 0 {
+		return ""
+	}
+	return username + address[at:]
+}
+
+func resourceOpsgenieEmailIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting OpsGenie email integration '%s'", d.Get("name").(string))
 	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	deleteRequest := &integration.DeleteIntegrationRequest{
 		Id: d.Id(),
 	}
 
-	_, err = client.Delete(context.Background(), deleteRequest)
+	_, err = client.Delete(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
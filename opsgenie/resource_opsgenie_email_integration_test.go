@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -75,6 +76,43 @@ func TestAccOpsGenieEmailIntegration_basic(t *testing.T) {
 	})
 }
 
+func TestAccOpsGenieEmailIntegration_updateUsername(t *testing.T) {
+	randomName := acctest.RandString(6)
+	randomMail := acctest.RandString(6)
+	var integrationId string
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testCheckOpsGenieEmailIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsGenieEmailIntegration_basic(randomMail, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOpsGenieEmailIntegrationExists("opsgenie_email_integration.test"),
+					resource.TestMatchResourceAttr("opsgenie_email_integration.test", "email_address", regexp.MustCompile("^fahri-"+randomMail+"@")),
+					func(s *terraform.State) error {
+						integrationId = s.RootModule().Resources["opsgenie_email_integration.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccOpsGenieEmailIntegration_basic(randomMail+"-new", randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("opsgenie_email_integration.test", "id", &integrationId),
+					resource.TestCheckResourceAttr("opsgenie_email_integration.test", "email_username", "fahri-"+randomMail+"-new"),
+					resource.TestMatchResourceAttr("opsgenie_email_integration.test", "email_address", regexp.MustCompile("^fahri-"+randomMail+"-new@")),
+				),
+			},
+			{
+				ResourceName:      "opsgenie_email_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccOpsGenieEmailIntegration_complete(t *testing.T) {
 	randomName := acctest.RandString(6)
 	randomTeam := acctest.RandString(6)
@@ -92,12 +130,24 @@ func TestAccOpsGenieEmailIntegration_complete(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					testCheckOpsGenieEmailIntegrationExists("opsgenie_email_integration.test"),
+					resource.TestCheckResourceAttr("opsgenie_email_integration.test", "responders.#", "4"),
+					resource.TestCheckResourceAttr("opsgenie_email_integration.test", "ignore_responders_from_payload", "true"),
+					resource.TestCheckResourceAttr("opsgenie_email_integration.test", "suppress_notifications", "true"),
 				),
 			},
 		},
 	})
 }
 
+func TestOpsgenieEmailIntegrationAddress(t *testing.T) {
+	if actual := opsgenieEmailIntegrationAddress("old@example.opsgenie.net", "new"); actual != "new@example.opsgenie.net" {
+		t.Errorf("expected the username to be replaced, got %q", actual)
+	}
+	if actual := opsgenieEmailIntegrationAddress("", "new"); actual != "" {
+		t.Errorf("expected no address without a domain, got %q", actual)
+	}
+}
+
 func testCheckOpsGenieEmailIntegrationDestroy(s *terraform.State) error {
 	client, err := integration.NewClient(testAccProvider.Meta().(*OpsgenieClient).client.Config)
 	if err != nil {
@@ -5,21 +5,29 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/escalation"
 )
 
 func resourceOpsgenieEscalation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsgenieEscalationCreate,
-		Read:   handleNonExistentResource(resourceOpsgenieEscalationRead),
-		Update: resourceOpsgenieEscalationUpdate,
-		Delete: resourceOpsgenieEscalationDelete,
+		CreateContext: resourceOpsgenieEscalationCreate,
+		ReadContext:   resourceOpsgenieEscalationRead,
+		UpdateContext: resourceOpsgenieEscalationUpdate,
+		DeleteContext: resourceOpsgenieEscalationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -101,10 +109,10 @@ func resourceOpsgenieEscalation() *schema.Resource {
 	}
 }
 
-func resourceOpsgenieEscalationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieEscalationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := escalation.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -125,20 +133,20 @@ func resourceOpsgenieEscalationCreate(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[INFO] Creating OpsGenie escalation '%s'", name)
 
-	result, err := client.Create(context.Background(), createRequest)
+	result, err := client.Create(ctx, createRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(result.Id)
 
-	return resourceOpsgenieEscalationRead(d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsgenieEscalationRead)
 }
 
-func resourceOpsgenieEscalationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieEscalationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := escalation.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	getRequest := &escalation.GetRequest{
@@ -146,9 +154,9 @@ func resourceOpsgenieEscalationRead(d *schema.ResourceData, meta interface{}) er
 		Identifier:     d.Id(),
 	}
 
-	getResponse, err := client.Get(context.Background(), getRequest)
+	getResponse, err := client.Get(ctx, getRequest)
 	if err != nil {
-		return err
+		return handleNonExistentResource(d, err)
 	}
 
 	d.Set("name", getResponse.Name)
@@ -165,10 +173,10 @@ func resourceOpsgenieEscalationRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceOpsgenieEscalationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieEscalationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := escalation.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -191,28 +199,30 @@ func resourceOpsgenieEscalationUpdate(d *schema.ResourceData, meta interface{})
 	}
 	log.Printf("[INFO] Updating OpsGenie escalation '%s'", name)
 
-	_, err = client.Update(context.Background(), updateRequest)
+	recordOpsGenieUserReferencesChanged(d.Id())
+	_, err = client.Update(ctx, updateRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
 }
 
-func resourceOpsgenieEscalationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieEscalationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting OpsGenie escalation '%s'", d.Get("name").(string))
 	client, err := escalation.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	deleteRequest := &escalation.DeleteRequest{
 		IdentifierType: escalation.Id,
 		Identifier:     d.Id(),
 	}
 
-	_, err = client.Delete(context.Background(), deleteRequest)
+	recordOpsGenieUserReferencesChanged(d.Id())
+	_, err = client.Delete(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
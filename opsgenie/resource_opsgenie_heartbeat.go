@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/heartbeat"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
@@ -12,12 +14,18 @@ import (
 
 func resourceOpsgenieHeartbeat() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsgenieHeartbeatCreate,
-		Read:   handleNonExistentResource(resourceOpsgenieHeartbeatRead),
-		Update: resourceOpsgenieHeartbeatUpdate,
-		Delete: resourceOpsgenieHeartbeatDelete,
+		CreateContext: resourceOpsgenieHeartbeatCreate,
+		ReadContext:   resourceOpsgenieHeartbeatRead,
+		UpdateContext: resourceOpsgenieHeartbeatUpdate,
+		DeleteContext: resourceOpsgenieHeartbeatDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -66,10 +74,10 @@ func resourceOpsgenieHeartbeat() *schema.Resource {
 	}
 }
 
-func resourceOpsgenieHeartbeatCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieHeartbeatCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := heartbeat.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -96,25 +104,25 @@ func resourceOpsgenieHeartbeatCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	result, err := client.Add(context.Background(), addRequest)
+	result, err := client.Add(ctx, addRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(result.Heartbeat.Name)
 
-	return resourceOpsgenieHeartbeatRead(d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsgenieHeartbeatRead)
 }
 
-func resourceOpsgenieHeartbeatRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieHeartbeatRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := heartbeat.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
-	result, err := client.Get(context.Background(), d.Id())
+	result, err := client.Get(ctx, d.Id())
 	if err != nil {
-		return err
+		return handleNonExistentResource(d, err)
 	}
 
 	d.Set("name", result.Name)
@@ -130,10 +138,10 @@ func resourceOpsgenieHeartbeatRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceOpsgenieHeartbeatUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieHeartbeatUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := heartbeat.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -160,23 +168,23 @@ func resourceOpsgenieHeartbeatUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	_, err = client.Update(context.Background(), updateRequest)
+	_, err = client.Update(ctx, updateRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
 }
 
-func resourceOpsgenieHeartbeatDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieHeartbeatDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := heartbeat.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
-	_, err = client.Delete(context.Background(), d.Id())
+	_, err = client.Delete(ctx, d.Id())
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
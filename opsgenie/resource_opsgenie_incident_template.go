@@ -2,20 +2,28 @@ package opsgenie
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/incident"
 	"log"
+	"time"
 )
 
 func resourceOpsgenieIncidentTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsgenieIncidentTemplateCreate,
-		Read:   handleNonExistentResource(resourceOpsgenieIncidentTemplateRead),
-		Update: resourceOpsgenieIncidentTemplateUpdate,
-		Delete: resourceOpsgenieIncidentTemplateDelete,
+		CreateContext: resourceOpsgenieIncidentTemplateCreate,
+		ReadContext:   resourceOpsgenieIncidentTemplateRead,
+		UpdateContext: resourceOpsgenieIncidentTemplateUpdate,
+		DeleteContext: resourceOpsgenieIncidentTemplateDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -81,10 +89,10 @@ func resourceOpsgenieIncidentTemplate() *schema.Resource {
 	}
 }
 
-func resourceOpsgenieIncidentTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieIncidentTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := incident.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	createRequest := &incident.CreateIncidentTemplateRequest{
 		Name:                  d.Get("name").(string),
@@ -96,26 +104,28 @@ func resourceOpsgenieIncidentTemplateCreate(d *schema.ResourceData, meta interfa
 		ImpactedServices:      expandOpsgenieIncidentTemplateImpactedServices(d.Get("impacted_services").(*schema.Set)),
 		StakeholderProperties: expandOpsgenieIncidentTemplateStakeholderProperties(d.Get("stakeholder_properties").([]interface{})),
 	}
-	result, err := client.CreateIncidentTemplate(context.Background(), createRequest)
+	result, err := client.CreateIncidentTemplate(ctx, createRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	d.SetId(result.IncidentTemplateId)
-	return resourceOpsgenieIncidentTemplateRead(d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsgenieIncidentTemplateRead)
 }
 
-func resourceOpsgenieIncidentTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieIncidentTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := incident.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
-	result, err := client.GetIncidentTemplate(context.Background(), &incident.GetIncidentTemplateRequest{})
+	result, err := client.GetIncidentTemplate(ctx, &incident.GetIncidentTemplateRequest{})
 	if err != nil {
-		return err
+		return handleNonExistentResource(d, err)
 	}
+	found := false
 	if result != nil {
 		for _, value := range result.IncidentTemplates["incidentTemplates"] {
 			if d.Id() == value.IncidentTemplateId {
+				found = true
 				d.Set("name", value.Name)
 				d.Set("message", value.Message)
 				d.Set("tags", value.Tags)
@@ -129,17 +139,18 @@ func resourceOpsgenieIncidentTemplateRead(d *schema.ResourceData, meta interface
 				break
 			}
 		}
-	} else {
+	}
+	if !found {
 		d.SetId("")
-		log.Printf("[INFO] Incident template not found")
+		log.Printf("[INFO] Incident template not found. Removing from state")
 	}
 	return nil
 }
 
-func resourceOpsgenieIncidentTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieIncidentTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := incident.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	updateRequest := &incident.UpdateIncidentTemplateRequest{
 		IncidentTemplateId:    d.Id(),
@@ -152,22 +163,22 @@ func resourceOpsgenieIncidentTemplateUpdate(d *schema.ResourceData, meta interfa
 		ImpactedServices:      expandOpsgenieIncidentTemplateImpactedServices(d.Get("impacted_services").(*schema.Set)),
 		StakeholderProperties: expandOpsgenieIncidentTemplateStakeholderProperties(d.Get("stakeholder_properties").([]interface{})),
 	}
-	_, err = client.UpdateIncidentTemplate(context.Background(), updateRequest)
+	_, err = client.UpdateIncidentTemplate(ctx, updateRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	return nil
 }
 
-func resourceOpsgenieIncidentTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieIncidentTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := incident.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	deleteRequest := &incident.DeleteIncidentTemplateRequest{IncidentTemplateId: d.Id()}
-	_, err = client.DeleteIncidentTemplate(context.Background(), deleteRequest)
+	_, err = client.DeleteIncidentTemplate(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	return nil
 }
@@ -2,23 +2,29 @@ package opsgenie
 
 import (
 	"context"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	ogClient "github.com/opsgenie/opsgenie-go-sdk-v2/client"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/integration"
 )
 
 func resourceOpsgenieIntegrationAction() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsgenieIntegrationActionCreate,
-		Read:   handleNonExistentResource(resourceOpsgenieIntegrationActionRead),
-		Update: resourceOpsgenieIntegrationActionUpdate,
-		Delete: resourceOpsgenieIntegrationActionDelete,
+		CreateContext: resourceOpsgenieIntegrationActionCreate,
+		ReadContext:   resourceOpsgenieIntegrationActionRead,
+		UpdateContext: resourceOpsgenieIntegrationActionUpdate,
+		DeleteContext: resourceOpsgenieIntegrationActionDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"integration_id": {
@@ -48,52 +54,7 @@ func resourceOpsgenieIntegrationAction() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
-						"filter": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"type": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice([]string{"match-all", "match-any-condition", "match-all-conditions"}, false),
-									},
-									"conditions": {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"field": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"key": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"not": {
-													Type:     schema.TypeBool,
-													Optional: true,
-													Default:  false,
-												},
-												"operation": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"expected_value": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"order": {
-													Type:     schema.TypeInt,
-													Optional: true,
-												},
-											},
-										},
-									},
-								},
-							},
-						},
+						"filter": integrationFilterSchema(),
 						"user": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -204,323 +165,113 @@ func resourceOpsgenieIntegrationAction() *schema.Resource {
 					},
 				},
 			},
-			"close": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"type": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "close",
-						},
-						"order": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  1,
-						},
-						"filter": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"type": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice([]string{"match-all", "match-any-condition", "match-all-conditions"}, false),
-									},
-									"conditions": {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"field": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"key": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"not": {
-													Type:     schema.TypeBool,
-													Optional: true,
-													Default:  false,
-												},
-												"operation": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"expected_value": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"order": {
-													Type:     schema.TypeInt,
-													Optional: true,
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						"user": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "{{user}}",
-						},
-						"note": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "{{note}}",
-						},
-						"alias": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "{{alias}}",
-						},
-					},
+			"close":         integrationActionBlockSchema("close", nil),
+			"acknowledge":   integrationActionBlockSchema("acknowledge", nil),
+			"add_note":      integrationActionBlockSchema("addNote", nil),
+			"unacknowledge": integrationActionBlockSchema("unacknowledge", nil),
+			"snooze": integrationActionBlockSchema("snooze", map[string]*schema.Schema{
+				"end_time": {
+					Type:     schema.TypeString,
+					Required: true,
 				},
-			},
-			"acknowledge": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"type": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "acknowledge",
-						},
-						"order": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  1,
-						},
-						"filter": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"type": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice([]string{"match-all", "match-any-condition", "match-all-conditions"}, false),
-									},
-									"conditions": {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"field": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"key": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"not": {
-													Type:     schema.TypeBool,
-													Optional: true,
-													Default:  false,
-												},
-												"operation": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"expected_value": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"order": {
-													Type:     schema.TypeInt,
-													Optional: true,
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						"user": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "{{user}}",
-						},
-						"note": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "{{note}}",
-						},
-						"alias": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "{{alias}}",
-						},
+			}),
+			"add_tags": integrationActionBlockSchema("addTags", map[string]*schema.Schema{
+				"tags": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
 					},
+					Set: schema.HashString,
 				},
-			},
-			"add_note": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"type": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "addNote",
-						},
-						"order": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  1,
-						},
-						"filter": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"type": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice([]string{"match-all", "match-any-condition", "match-all-conditions"}, false),
-									},
-									"conditions": {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"field": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"key": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"not": {
-													Type:     schema.TypeBool,
-													Optional: true,
-													Default:  false,
-												},
-												"operation": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"expected_value": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"order": {
-													Type:     schema.TypeInt,
-													Optional: true,
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						"user": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "{{user}}",
-						},
-						"note": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "{{note}}",
-						},
-						"alias": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "{{alias}}",
-						},
+			}),
+			"remove_tags": integrationActionBlockSchema("removeTags", map[string]*schema.Schema{
+				"tags": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
 					},
+					Set: schema.HashString,
 				},
-			},
-			"ignore": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"type": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "ignore",
-						},
-						"order": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  1,
-						},
-						"filter": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"type": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringInSlice([]string{"match-all", "match-any-condition", "match-all-conditions"}, false),
-									},
-									"conditions": {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"field": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"key": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"not": {
-													Type:     schema.TypeBool,
-													Optional: true,
-													Default:  false,
-												},
-												"operation": {
-													Type:     schema.TypeString,
-													Required: true,
-												},
-												"expected_value": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"order": {
-													Type:     schema.TypeInt,
-													Optional: true,
-												},
-											},
-										},
-									},
-								},
-							},
-						},
+			}),
+			"add_details": integrationActionBlockSchema("addDetails", map[string]*schema.Schema{
+				"details": {
+					Type:     schema.TypeMap,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
 					},
 				},
-			},
+			}),
+			"assign_ownership": integrationActionBlockSchema("assignOwnership", map[string]*schema.Schema{
+				"owner": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			}),
+			"ignore": integrationBaseActionBlockSchema("ignore", nil),
+		},
+	}
+}
+
+// integrationActionBlockSchema is the block of an action type which takes the
+// fields most actions have, plus the given fields specific to the type.
+func integrationActionBlockSchema(actionType string, fields map[string]*schema.Schema) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"user": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "{{user}}",
+		},
+		"note": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "{{note}}",
+		},
+		"alias": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "{{alias}}",
+		},
+	}
+	for k, v := range fields {
+		s[k] = v
+	}
+
+	return integrationBaseActionBlockSchema(actionType, s)
+}
+
+// integrationBaseActionBlockSchema is the block of an action type which takes
+// the fields every action has, plus the given fields. Ignore actions do not act
+// on an alert, so they take no user, note or alias.
+func integrationBaseActionBlockSchema(actionType string, fields map[string]*schema.Schema) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  actionType,
+		},
+		"order": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  1,
+		},
+		"filter": integrationFilterSchema(),
+	}
+	for k, v := range fields {
+		s[k] = v
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: s,
 		},
 	}
 }
@@ -571,9 +322,9 @@ func expandOpsgenieFilter(input []interface{}) integration.Filter {
 	return filter
 }
 
-func expandOpsgenieIntegrationActions(input interface{}) []integration.IntegrationAction {
+func expandOpsgenieIntegrationActions(input interface{}) []integrationAction {
 
-	actions := make([]integration.IntegrationAction, 0)
+	actions := make([]integrationAction, 0)
 
 	if input == nil {
 		return actions
@@ -581,7 +332,7 @@ func expandOpsgenieIntegrationActions(input interface{}) []integration.Integrati
 
 	for _, v := range input.([]interface{}) {
 		inputMap := v.(map[string]interface{})
-		action := integration.IntegrationAction{}
+		action := integrationAction{}
 
 		action.Type = integration.ActionType(inputMap["type"].(string))
 		action.Name = inputMap["name"].(string)
@@ -626,6 +377,17 @@ func expandOpsgenieIntegrationActions(input interface{}) []integration.Integrati
 			action.Responders = expandOpsgenieActionResponders(inputMap["responders"].([]interface{}))
 		}
 
+		switch action.Type {
+		case "snooze":
+			action.EndTime = inputMap["end_time"].(string)
+		case "addTags", "removeTags":
+			action.Tags = flattenActionTags(inputMap["tags"].(*schema.Set))
+		case "addDetails":
+			action.Details = convertInterfaceMapToString(inputMap["details"].(map[string]interface{}))
+		case "assignOwnership":
+			action.Owner = inputMap["owner"].(string)
+		}
+
 		actions = append(actions, action)
 	}
 	return actions
@@ -664,7 +426,7 @@ func flattenActionTags(input *schema.Set) []string {
 	return tags
 }
 
-func flattenOpsgenieIntegrationActions(input []integration.IntegrationAction) []map[string]interface{} {
+func flattenOpsgenieIntegrationActions(input []integrationAction) []map[string]interface{} {
 
 	actions := make([]map[string]interface{}, 0)
 	for _, action := range input {
@@ -703,89 +465,73 @@ func flattenOpsgenieIntegrationActions(input []integration.IntegrationAction) []
 			actionMap["tags"] = action.Tags
 			actionMap["extra_properties"] = action.ExtraProperties
 		}
+		switch action.Type {
+		case "snooze":
+			actionMap["end_time"] = action.EndTime
+		case "addTags", "removeTags":
+			actionMap["tags"] = action.Tags
+		case "addDetails":
+			actionMap["details"] = action.Details
+		case "assignOwnership":
+			actionMap["owner"] = action.Owner
+		}
 		actions = append(actions, actionMap)
 	}
 	return actions
 }
 
-func resourceOpsgenieIntegrationActionCreate(d *schema.ResourceData, meta interface{}) error {
-	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
-	if err != nil {
-		return err
-	}
-
+func resourceOpsgenieIntegrationActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	integrationId := d.Get("integration_id").(string)
-	updateRequest := &integration.UpdateAllIntegrationActionsRequest{
-		Id:          integrationId,
-		Create:      expandOpsgenieIntegrationActions(d.Get("create")),
-		Close:       expandOpsgenieIntegrationActions(d.Get("close")),
-		Acknowledge: expandOpsgenieIntegrationActions(d.Get("acknowledge")),
-		AddNote:     expandOpsgenieIntegrationActions(d.Get("add_note")),
-		Ignore:      expandOpsgenieIntegrationActions(d.Get("ignore")),
+	integrationActionsMutexKV.Lock(integrationId)
+	defer integrationActionsMutexKV.Unlock(integrationId)
+
+	actions := make(integrationActions)
+	for block, actionType := range opsgenieIntegrationActionBlocks {
+		actions[actionType] = expandOpsgenieIntegrationActions(d.Get(block))
 	}
 
+	diags := opsgenieIntegrationFilterFieldWarnings(ctx, meta, integrationId, actions)
+
 	log.Printf("[INFO] Creating OpsGenie integration actions for '%s'", integrationId)
-	result, err := client.UpdateAllActions(context.Background(), updateRequest)
+	result, err := putOpsgenieIntegrationActions(ctx, meta, integrationId, actions)
 	if err != nil {
-		return err
+		return append(diags, apiErrorDiagnostics(err)...)
 	}
 
 	d.SetId(result.Parent.Id)
 	d.Set("integration_id", result.Parent.Id)
 
-	return resourceOpsgenieIntegrationActionRead(d, meta)
+	return append(diags, readAfterCreate(ctx, d, meta, resourceOpsgenieIntegrationActionRead)...)
 }
 
-func resourceOpsgenieIntegrationActionRead(d *schema.ResourceData, meta interface{}) error {
-	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
+func resourceOpsgenieIntegrationActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	result, err := getOpsgenieIntegrationActions(ctx, meta, d.Id())
 	if err != nil {
-		return err
-	}
-
-	result, err := client.GetActions(context.Background(), &integration.GetIntegrationActionsRequest{
-		BaseRequest: ogClient.BaseRequest{},
-		Id:          d.Id(),
-	})
-	if err != nil {
-		return err
+		return handleNonExistentResource(d, err)
 	}
 	d.SetId(result.Parent.Id)
 	d.Set("integration_id", result.Parent.Id)
-	d.Set("create", flattenOpsgenieIntegrationActions(result.Create))
-	d.Set("close", flattenOpsgenieIntegrationActions(result.Close))
-	d.Set("acknowledge", flattenOpsgenieIntegrationActions(result.Acknowledge))
-	d.Set("add_note", flattenOpsgenieIntegrationActions(result.AddNote))
-	d.Set("ignore", flattenOpsgenieIntegrationActions(result.Ignore))
+	for block, actionType := range opsgenieIntegrationActionBlocks {
+		d.Set(block, flattenOpsgenieIntegrationActions(result.Actions[actionType]))
+	}
 
 	return nil
 }
 
-func resourceOpsgenieIntegrationActionUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceOpsgenieIntegrationActionCreate(d, meta)
+func resourceOpsgenieIntegrationActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceOpsgenieIntegrationActionCreate(ctx, d, meta)
 }
 
-func resourceOpsgenieIntegrationActionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieIntegrationActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting OpsGenie api integration actions for '%s'", d.Get("integration_id").(string))
-	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
-	if err != nil {
-		return err
-	}
 
-	deleteRequest := &integration.UpdateAllIntegrationActionsRequest{
-		Id:          d.Get("integration_id").(string),
-		Create:      []integration.IntegrationAction{},
-		Close:       []integration.IntegrationAction{},
-		Acknowledge: []integration.IntegrationAction{},
-		AddNote:     []integration.IntegrationAction{},
-		Ignore:      []integration.IntegrationAction{},
-	}
+	integrationId := d.Get("integration_id").(string)
+	integrationActionsMutexKV.Lock(integrationId)
+	defer integrationActionsMutexKV.Unlock(integrationId)
 
-	_, err = client.UpdateAllActions(context.Background(), deleteRequest)
-	if err != nil {
-		apiError := err.(*ogClient.ApiError)
-		if apiError.StatusCode != 404 {
-			return err
-		}
+	_, err := putOpsgenieIntegrationActions(ctx, meta, integrationId, integrationActions{})
+	if err != nil && !isNotFoundError(err) {
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
@@ -5,11 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	ogClient "github.com/opsgenie/opsgenie-go-sdk-v2/client"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/integration"
@@ -116,6 +118,89 @@ func TestAccOpsGenieIntegrationAction_complete(t *testing.T) {
 	})
 }
 
+func TestAccOpsGenieIntegrationAction_additionalActionTypes(t *testing.T) {
+	rString := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testCheckOpsGenieIntegrationActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsGenieIntegrationAction_additionalActionTypes(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOpsGenieIntegrationActionExists("opsgenie_integration_action.test"),
+					resource.TestCheckResourceAttr("opsgenie_integration_action.test", "unacknowledge.#", "1"),
+					resource.TestCheckResourceAttr("opsgenie_integration_action.test", "snooze.0.end_time", "{{snooze_end_time}}"),
+					resource.TestCheckTypeSetElemAttr("opsgenie_integration_action.test", "add_tags.0.tags.*", "terraform"),
+					resource.TestCheckTypeSetElemAttr("opsgenie_integration_action.test", "remove_tags.0.tags.*", "stale"),
+					resource.TestCheckResourceAttr("opsgenie_integration_action.test", "add_details.0.details.env", "prod"),
+					resource.TestCheckResourceAttr("opsgenie_integration_action.test", "assign_ownership.0.owner", "{{user}}"),
+					resource.TestCheckResourceAttr("opsgenie_integration_action.test", "ignore.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpsGenieIntegrationAction_emailFilter(t *testing.T) {
+	rString := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testCheckOpsGenieIntegrationActionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsGenieIntegrationAction_emailFilter(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOpsGenieIntegrationActionExists("opsgenie_integration_action.test"),
+					resource.TestCheckResourceAttr("opsgenie_integration_action.test", "create.0.filter.0.conditions.0.field", "from_address"),
+					resource.TestCheckResourceAttr("opsgenie_integration_action.test", "create.0.filter.0.conditions.1.field", "subject"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandOpsgenieIntegrationActions_additionalActionTypes(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceOpsgenieIntegrationAction().Schema, map[string]interface{}{
+		"integration_id": "integration-id",
+		"snooze": []interface{}{
+			map[string]interface{}{"name": "snooze", "end_time": "{{snooze_end_time}}"},
+		},
+		"add_tags": []interface{}{
+			map[string]interface{}{"name": "tag", "tags": []interface{}{"terraform"}},
+		},
+		"add_details": []interface{}{
+			map[string]interface{}{"name": "details", "details": map[string]interface{}{"env": "prod"}},
+		},
+		"assign_ownership": []interface{}{
+			map[string]interface{}{"name": "assign", "owner": "{{user}}"},
+		},
+	})
+
+	snooze := expandOpsgenieIntegrationActions(d.Get("snooze"))
+	if len(snooze) != 1 || snooze[0].Type != "snooze" || snooze[0].EndTime != "{{snooze_end_time}}" || snooze[0].Alias != "{{alias}}" {
+		t.Errorf("unexpected snooze actions %#v", snooze)
+	}
+	addTags := expandOpsgenieIntegrationActions(d.Get("add_tags"))
+	if len(addTags) != 1 || addTags[0].Type != "addTags" || !reflect.DeepEqual(addTags[0].Tags, []string{"terraform"}) {
+		t.Errorf("unexpected add tags actions %#v", addTags)
+	}
+	addDetails := expandOpsgenieIntegrationActions(d.Get("add_details"))
+	if len(addDetails) != 1 || addDetails[0].Details["env"] != "prod" {
+		t.Errorf("unexpected add details actions %#v", addDetails)
+	}
+	assignOwnership := expandOpsgenieIntegrationActions(d.Get("assign_ownership"))
+	if len(assignOwnership) != 1 || assignOwnership[0].Owner != "{{user}}" {
+		t.Errorf("unexpected assign ownership actions %#v", assignOwnership)
+	}
+
+	flattened := flattenOpsgenieIntegrationActions(snooze)
+	if flattened[0]["end_time"] != "{{snooze_end_time}}" {
+		t.Errorf("expected the end time to be flattened, got %#v", flattened)
+	}
+}
+
 func testCheckOpsGenieIntegrationActionDestroy(s *terraform.State) error {
 	client, err := integration.NewClient(testAccProvider.Meta().(*OpsgenieClient).client.Config)
 	if err != nil {
@@ -222,6 +307,100 @@ resource "opsgenie_api_integration" "test" {
 `, rString)
 }
 
+func testAccOpsGenieIntegrationAction_additionalActionTypes(rString string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_api_integration" "test" {
+  type = "API"
+  name = "genieintegration-additional-%s"
+}
+resource "opsgenie_integration_action" "test" {
+  integration_id = opsgenie_api_integration.test.id
+  unacknowledge {
+    name = "Unack reopened alerts"
+    filter {
+      type = "match-all"
+    }
+  }
+  snooze {
+    name     = "Snooze maintenance alerts"
+    end_time = "{{snooze_end_time}}"
+    filter {
+      type = "match-all"
+    }
+  }
+  add_tags {
+    name = "Tag alerts"
+    tags = ["terraform"]
+    filter {
+      type = "match-all"
+    }
+  }
+  remove_tags {
+    name = "Untag alerts"
+    tags = ["stale"]
+    filter {
+      type = "match-all"
+    }
+  }
+  add_details {
+    name    = "Add environment"
+    details = {
+      env = "prod"
+    }
+    filter {
+      type = "match-all"
+    }
+  }
+  assign_ownership {
+    name  = "Assign to sender"
+    owner = "{{user}}"
+    filter {
+      type = "match-all"
+    }
+  }
+  ignore {
+    name = "Ignore test alerts"
+    filter {
+      type = "match-all-conditions"
+      conditions {
+        field          = "message"
+        operation      = "contains"
+        expected_value = "TEST"
+      }
+    }
+  }
+}
+`, rString)
+}
+
+func testAccOpsGenieIntegrationAction_emailFilter(rString string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_email_integration" "test" {
+  name           = "genieintegration-email-%s"
+  email_username = "genie-%s"
+}
+resource "opsgenie_integration_action" "test" {
+  integration_id = opsgenie_email_integration.test.id
+  create {
+    name = "Create alerts from monitoring"
+    filter {
+      type = "match-all-conditions"
+      conditions {
+        field          = "from_address"
+        operation      = "ends-with"
+        expected_value = "@monitoring.example.com"
+      }
+      conditions {
+        field          = "subject"
+        operation      = "contains"
+        expected_value = "CRITICAL"
+      }
+    }
+  }
+}
+`, rString, rString)
+}
+
 func testAccOpsGenieIntegrationAction_custompriority(rString, crString string) string {
 	return fmt.Sprintf(`
 resource "opsgenie_api_integration" "test" {
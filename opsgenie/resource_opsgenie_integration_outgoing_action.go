@@ -0,0 +1,142 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/integration"
+)
+
+// resourceOpsgenieIntegrationOutgoingAction manages which alert actions an
+// integration forwards to the system it pushes to, e.g. the endpoint of a
+// webhook integration. The settings are part of the integration itself, so the
+// resource is identified by the integration ID.
+func resourceOpsgenieIntegrationOutgoingAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOpsgenieIntegrationOutgoingActionCreate,
+		ReadContext:   resourceOpsgenieIntegrationOutgoingActionRead,
+		UpdateContext: resourceOpsgenieIntegrationOutgoingActionUpdate,
+		DeleteContext: resourceOpsgenieIntegrationOutgoingActionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"integration_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"alert_actions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+			"filter": opsgenieIntegrationOutgoingActionFilterSchema(),
+		},
+	}
+}
+
+// opsgenieIntegrationOutgoingActionFilterSchema is computed, as the filter is
+// shared with alert_filter of opsgenie_api_integration. Only the resource it is
+// configured on writes it.
+func opsgenieIntegrationOutgoingActionFilterSchema() *schema.Schema {
+	s := integrationFilterSchema()
+	s.MaxItems = 1
+	s.Computed = true
+	return s
+}
+
+func resourceOpsgenieIntegrationOutgoingActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	integrationId := d.Get("integration_id").(string)
+
+	log.Printf("[INFO] Creating OpsGenie outgoing actions for integration '%s'", integrationId)
+
+	if diags := applyOpsgenieIntegrationOutgoingAction(ctx, d, meta, integrationId); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(integrationId)
+
+	return readAfterCreate(ctx, d, meta, resourceOpsgenieIntegrationOutgoingActionRead)
+}
+
+func resourceOpsgenieIntegrationOutgoingActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	result, err := client.Get(ctx, &integration.GetRequest{
+		Id: d.Id(),
+	})
+	if err != nil {
+		return handleNonExistentResource(d, err)
+	}
+
+	alertActions, _ := result.Data["alertActions"].([]interface{})
+
+	d.Set("integration_id", d.Id())
+	d.Set("enabled", result.Data["sendAlertActions"] == true)
+	d.Set("alert_actions", alertActions)
+	d.Set("filter", flattenOpsgenieIntegrationFieldFilter(result.Data["alertFilter"]))
+
+	return nil
+}
+
+func resourceOpsgenieIntegrationOutgoingActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Updating OpsGenie outgoing actions for integration '%s'", d.Id())
+
+	if diags := applyOpsgenieIntegrationOutgoingAction(ctx, d, meta, d.Id()); diags.HasError() {
+		return diags
+	}
+
+	return resourceOpsgenieIntegrationOutgoingActionRead(ctx, d, meta)
+}
+
+func resourceOpsgenieIntegrationOutgoingActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Deleting OpsGenie outgoing actions for integration '%s'", d.Id())
+
+	// The filter is left as it is, since it may be managed by alert_filter of
+	// opsgenie_api_integration as well.
+	err := updateOpsgenieIntegrationFields(ctx, meta, d.Id(), func(fields map[string]interface{}) {
+		fields["sendAlertActions"] = false
+		fields["alertActions"] = []string{}
+	})
+	if err != nil && !isNotFoundError(err) {
+		return apiErrorDiagnostics(err)
+	}
+
+	return nil
+}
+
+func applyOpsgenieIntegrationOutgoingAction(ctx context.Context, d *schema.ResourceData, meta interface{}, integrationId string) diag.Diagnostics {
+	err := updateOpsgenieIntegrationFields(ctx, meta, integrationId, func(fields map[string]interface{}) {
+		fields["sendAlertActions"] = d.Get("enabled").(bool)
+		fields["alertActions"] = convertInterfaceSliceToString(d.Get("alert_actions").(*schema.Set).List())
+		if filter := d.Get("filter").([]interface{}); len(filter) > 0 {
+			fields["alertFilter"] = expandOpsgenieFilter(filter)
+		}
+	})
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package opsgenie
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/integration"
+)
+
+func TestAccOpsGenieIntegrationOutgoingAction_basic(t *testing.T) {
+	rs := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testCheckOpsGenieApiIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsGenieIntegrationOutgoingAction_basic(rs, `"Create", "Close"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOpsGenieIntegrationOutgoingActionExists("opsgenie_integration_outgoing_action.test"),
+					resource.TestCheckResourceAttrPair("opsgenie_integration_outgoing_action.test", "integration_id", "opsgenie_api_integration.test", "id"),
+					resource.TestCheckResourceAttr("opsgenie_integration_outgoing_action.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("opsgenie_integration_outgoing_action.test", "alert_actions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("opsgenie_integration_outgoing_action.test", "alert_actions.*", "Close"),
+					resource.TestCheckResourceAttr("opsgenie_integration_outgoing_action.test", "filter.0.type", "match-all-conditions"),
+					resource.TestCheckResourceAttr("opsgenie_integration_outgoing_action.test", "filter.0.conditions.#", "1"),
+				),
+			},
+			{
+				Config: testAccOpsGenieIntegrationOutgoingAction_basic(rs, `"Create", "Acknowledge", "AddNote"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opsgenie_integration_outgoing_action.test", "alert_actions.#", "3"),
+					resource.TestCheckTypeSetElemAttr("opsgenie_integration_outgoing_action.test", "alert_actions.*", "AddNote"),
+				),
+			},
+			{
+				ResourceName:      "opsgenie_integration_outgoing_action.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckOpsGenieIntegrationOutgoingActionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		client, err := integration.NewClient(testAccProvider.Meta().(*OpsgenieClient).client.Config)
+		if err != nil {
+			return err
+		}
+		id := rs.Primary.Attributes["id"]
+
+		result, err := client.Get(context.Background(), &integration.GetRequest{
+			Id: id,
+		})
+		if err != nil {
+			return fmt.Errorf("Bad: Integration with id %q does not exist", id)
+		}
+		if result.Data["sendAlertActions"] != true {
+			return fmt.Errorf("Bad: Integration with id %q does not send alert actions", id)
+		}
+		return nil
+	}
+}
+
+func testAccOpsGenieIntegrationOutgoingAction_basic(randomName, alertActions string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_api_integration" "test" {
+  name        = "genieintegration-outgoing-%s"
+  type        = "Webhook"
+  webhook_url = "https://example.com/v1"
+}
+
+resource "opsgenie_integration_outgoing_action" "test" {
+  integration_id = opsgenie_api_integration.test.id
+  alert_actions  = [%s]
+
+  filter {
+    type = "match-all-conditions"
+    conditions {
+      field          = "priority"
+      operation      = "equals"
+      expected_value = "P1"
+    }
+  }
+}
+`, randomName, alertActions)
+}
@@ -0,0 +1,279 @@
+package opsgenie
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceOpsgenieIntegrationSingleAction manages one action of an
+// integration, identified by its type and name, and leaves the other actions
+// of the integration as they are. This allows several configurations to add
+// actions to the same integration, unlike opsgenie_integration_action which
+// owns all of them.
+func resourceOpsgenieIntegrationSingleAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOpsgenieIntegrationSingleActionCreate,
+		ReadContext:   resourceOpsgenieIntegrationSingleActionRead,
+		UpdateContext: resourceOpsgenieIntegrationSingleActionUpdate,
+		DeleteContext: resourceOpsgenieIntegrationSingleActionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if _, _, _, err := parseOpsgenieIntegrationSingleActionId(d.Id()); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		CustomizeDiff: customizeOpsgenieIntegrationSingleActionDiff,
+		Schema:        opsgenieIntegrationSingleActionSchema(),
+	}
+}
+
+// opsgenieIntegrationSingleActionSchema takes the fields of an action from the
+// action blocks of opsgenie_integration_action, in the order of the action
+// types. Fields which do not apply to the type of the action are ignored, so
+// the fields which are required for one type only are optional here and
+// enforced by customizeOpsgenieIntegrationSingleActionDiff instead.
+func opsgenieIntegrationSingleActionSchema() map[string]*schema.Schema {
+	actionSchema := resourceOpsgenieIntegrationAction().Schema
+
+	s := map[string]*schema.Schema{
+		"integration_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(opsgenieIntegrationActionTypes, false),
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+	}
+	for _, actionType := range opsgenieIntegrationActionTypes {
+		block := opsgenieIntegrationActionBlock(actionType)
+		for k, v := range actionSchema[block].Elem.(*schema.Resource).Schema {
+			if _, ok := s[k]; ok {
+				continue
+			}
+			field := *v
+			if field.Required {
+				field.Required = false
+				field.Optional = true
+			}
+			s[k] = &field
+		}
+	}
+	return s
+}
+
+// opsgenieIntegrationActionBlock returns the opsgenie_integration_action block
+// of an action type.
+func opsgenieIntegrationActionBlock(actionType string) string {
+	for block, t := range opsgenieIntegrationActionBlocks {
+		if t == actionType {
+			return block
+		}
+	}
+	return ""
+}
+
+// customizeOpsgenieIntegrationSingleActionDiff requires the fields which the
+// opsgenie_integration_action block of the action type requires.
+func customizeOpsgenieIntegrationSingleActionDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	actionType := d.Get("type").(string)
+	block := opsgenieIntegrationActionBlock(actionType)
+	if block == "" {
+		return nil
+	}
+
+	fields := resourceOpsgenieIntegrationAction().Schema[block].Elem.(*schema.Resource).Schema
+	required := make([]string, 0)
+	for k, v := range fields {
+		if v.Required {
+			required = append(required, k)
+		}
+	}
+	sort.Strings(required)
+
+	for _, k := range required {
+		if _, ok := d.GetOk(k); !ok && d.NewValueKnown(k) {
+			return fmt.Errorf("%q is required for %s actions", k, actionType)
+		}
+	}
+	return nil
+}
+
+func opsgenieIntegrationSingleActionId(integrationId, actionType, name string) string {
+	return fmt.Sprintf("%s/%s/%s", integrationId, actionType, name)
+}
+
+func parseOpsgenieIntegrationSingleActionId(id string) (string, string, string, error) {
+	idParts := strings.SplitN(id, "/", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected integration_id/type/name", id)
+	}
+	return idParts[0], idParts[1], idParts[2], nil
+}
+
+func expandOpsgenieIntegrationSingleAction(d *schema.ResourceData) integrationAction {
+	input := make(map[string]interface{})
+	for k := range opsgenieIntegrationSingleActionSchema() {
+		input[k] = d.Get(k)
+	}
+	return expandOpsgenieIntegrationActions([]interface{}{input})[0]
+}
+
+func resourceOpsgenieIntegrationSingleActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	integrationId := d.Get("integration_id").(string)
+	actionType := d.Get("type").(string)
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] Creating OpsGenie %s action '%s' of integration '%s'", actionType, name, integrationId)
+
+	action := expandOpsgenieIntegrationSingleAction(d)
+	diags := opsgenieIntegrationFilterFieldWarnings(ctx, meta, integrationId, integrationActions{actionType: {action}})
+
+	err := updateOpsgenieIntegrationActions(ctx, meta, integrationId, func(actions integrationActions) error {
+		if opsgenieIntegrationActionIndex(actions[actionType], name) >= 0 {
+			return fmt.Errorf("%s action '%s' already exists on integration '%s', import it instead", actionType, name, integrationId)
+		}
+		actions[actionType] = append(actions[actionType], action)
+		return nil
+	})
+	if err != nil {
+		return append(diags, apiErrorDiagnostics(err)...)
+	}
+
+	d.SetId(opsgenieIntegrationSingleActionId(integrationId, actionType, name))
+
+	return append(diags, readAfterCreate(ctx, d, meta, resourceOpsgenieIntegrationSingleActionRead)...)
+}
+
+func resourceOpsgenieIntegrationSingleActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	integrationId, actionType, name, err := parseOpsgenieIntegrationSingleActionId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result, err := getOpsgenieIntegrationActions(ctx, meta, integrationId)
+	if err != nil {
+		return handleNonExistentResource(d, err)
+	}
+
+	actions := result.Actions
+	index := opsgenieIntegrationActionIndex(actions[actionType], name)
+	if index < 0 {
+		log.Printf("[WARN] Removing %s from state because the action no longer exists in OpsGenie", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("integration_id", integrationId)
+	d.Set("type", actionType)
+	d.Set("name", name)
+	flattened := flattenOpsgenieIntegrationActions(actions[actionType][index : index+1])[0]
+	for k, v := range flattened {
+		if k == "type" || k == "name" {
+			continue
+		}
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func resourceOpsgenieIntegrationSingleActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	integrationId, actionType, name, err := parseOpsgenieIntegrationSingleActionId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Updating OpsGenie %s action '%s' of integration '%s'", actionType, name, integrationId)
+
+	action := expandOpsgenieIntegrationSingleAction(d)
+	diags := opsgenieIntegrationFilterFieldWarnings(ctx, meta, integrationId, integrationActions{actionType: {action}})
+
+	err = updateOpsgenieIntegrationActions(ctx, meta, integrationId, func(actions integrationActions) error {
+		if index := opsgenieIntegrationActionIndex(actions[actionType], name); index >= 0 {
+			actions[actionType][index] = action
+		} else {
+			actions[actionType] = append(actions[actionType], action)
+		}
+		return nil
+	})
+	if err != nil {
+		return append(diags, apiErrorDiagnostics(err)...)
+	}
+
+	return append(diags, resourceOpsgenieIntegrationSingleActionRead(ctx, d, meta)...)
+}
+
+func resourceOpsgenieIntegrationSingleActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	integrationId, actionType, name, err := parseOpsgenieIntegrationSingleActionId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting OpsGenie %s action '%s' of integration '%s'", actionType, name, integrationId)
+
+	err = updateOpsgenieIntegrationActions(ctx, meta, integrationId, func(actions integrationActions) error {
+		if index := opsgenieIntegrationActionIndex(actions[actionType], name); index >= 0 {
+			actions[actionType] = append(actions[actionType][:index], actions[actionType][index+1:]...)
+		}
+		return nil
+	})
+	if err != nil && !isNotFoundError(err) {
+		return apiErrorDiagnostics(err)
+	}
+
+	return nil
+}
+
+func opsgenieIntegrationActionIndex(actions []integrationAction, name string) int {
+	for i, action := range actions {
+		if action.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// updateOpsgenieIntegrationActions reads the actions of an integration, lets
+// update change them and writes all of them back, holding the lock of the
+// integration in between.
+func updateOpsgenieIntegrationActions(ctx context.Context, meta interface{}, integrationId string, update func(actions integrationActions) error) error {
+	integrationActionsMutexKV.Lock(integrationId)
+	defer integrationActionsMutexKV.Unlock(integrationId)
+
+	result, err := getOpsgenieIntegrationActions(ctx, meta, integrationId)
+	if err != nil {
+		return err
+	}
+
+	if err := update(result.Actions); err != nil {
+		return err
+	}
+
+	_, err = putOpsgenieIntegrationActions(ctx, meta, integrationId, result.Actions)
+	return err
+}
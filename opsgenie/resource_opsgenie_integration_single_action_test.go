@@ -0,0 +1,163 @@
+package opsgenie
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOpsGenieIntegrationSingleAction_basic(t *testing.T) {
+	rs := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testCheckOpsGenieApiIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsGenieIntegrationSingleAction_basic(rs, "P3"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOpsGenieIntegrationSingleActionExists("opsgenie_integration_single_action.create"),
+					testCheckOpsGenieIntegrationSingleActionExists("opsgenie_integration_single_action.close"),
+					resource.TestCheckResourceAttr("opsgenie_integration_single_action.create", "priority", "P3"),
+					resource.TestCheckResourceAttr("opsgenie_integration_single_action.create", "tags.#", "1"),
+					resource.TestCheckResourceAttr("opsgenie_integration_single_action.close", "filter.0.conditions.#", "1"),
+				),
+			},
+			{
+				Config: testAccOpsGenieIntegrationSingleAction_basic(rs, "P1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opsgenie_integration_single_action.create", "priority", "P1"),
+					testCheckOpsGenieIntegrationSingleActionExists("opsgenie_integration_single_action.close"),
+				),
+			},
+			{
+				ResourceName:      "opsgenie_integration_single_action.create",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOpsGenieIntegrationSingleAction_requiredFields(t *testing.T) {
+	rs := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOpsGenieIntegrationSingleAction_snoozeWithoutEndTime(rs),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"end_time" is required for snooze actions`),
+			},
+		},
+	})
+}
+
+func TestOpsgenieIntegrationSingleActionSchema_order(t *testing.T) {
+	expected := opsgenieIntegrationSingleActionSchema()
+	for i := 0; i < 10; i++ {
+		for k, v := range opsgenieIntegrationSingleActionSchema() {
+			if v.Type != expected[k].Type || v.Optional != expected[k].Optional || !reflect.DeepEqual(v.Default, expected[k].Default) {
+				t.Fatalf("expected %q to be taken from the same block every time", k)
+			}
+		}
+	}
+	// the create block comes first, so its optional tags win over the
+	// required tags of add_tags
+	if expected["tags"].Required {
+		t.Errorf("expected tags to be optional")
+	}
+}
+
+func testCheckOpsGenieIntegrationSingleActionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		integrationId, actionType, actionName, err := parseOpsgenieIntegrationSingleActionId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		result, err := getOpsgenieIntegrationActions(context.Background(), testAccProvider.Meta(), integrationId)
+		if err != nil {
+			return fmt.Errorf("Bad: Integration with id %q does not exist", integrationId)
+		}
+		if opsgenieIntegrationActionIndex(result.Actions[actionType], actionName) < 0 {
+			return fmt.Errorf("Bad: %s action %q does not exist on integration %q", actionType, actionName, integrationId)
+		}
+		return nil
+	}
+}
+
+func testAccOpsGenieIntegrationSingleAction_basic(randomName, priority string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_api_integration" "test" {
+  name = "genieintegration-single-action-%s"
+  type = "API"
+}
+
+resource "opsgenie_integration_single_action" "create" {
+  integration_id = opsgenie_api_integration.test.id
+  type           = "create"
+  name           = "create from payload"
+  priority       = "%s"
+  tags           = ["terraform"]
+}
+
+resource "opsgenie_integration_single_action" "close" {
+  integration_id = opsgenie_api_integration.test.id
+  type           = "close"
+  name           = "close resolved"
+
+  filter {
+    type = "match-all-conditions"
+    conditions {
+      field          = "message"
+      operation      = "contains"
+      expected_value = "resolved"
+    }
+  }
+}
+`, randomName, priority)
+}
+
+func TestParseOpsgenieIntegrationSingleActionId(t *testing.T) {
+	integrationId, actionType, name, err := parseOpsgenieIntegrationSingleActionId("integration-id/create/create from payload/v2")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if integrationId != "integration-id" || actionType != "create" || name != "create from payload/v2" {
+		t.Errorf("unexpected parts %q, %q, %q", integrationId, actionType, name)
+	}
+
+	for _, id := range []string{"integration-id", "integration-id/create", "/create/name", "integration-id//name"} {
+		if _, _, _, err := parseOpsgenieIntegrationSingleActionId(id); err == nil {
+			t.Errorf("expected an error for %q", id)
+		}
+	}
+}
+
+func testAccOpsGenieIntegrationSingleAction_snoozeWithoutEndTime(randomName string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_api_integration" "test" {
+  name = "genieintegration-single-action-%s"
+  type = "API"
+}
+
+resource "opsgenie_integration_single_action" "snooze" {
+  integration_id = opsgenie_api_integration.test.id
+  type           = "snooze"
+  name           = "snooze without end time"
+}
+`, randomName)
+}
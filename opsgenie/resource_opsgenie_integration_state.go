@@ -0,0 +1,190 @@
+package opsgenie
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/integration"
+)
+
+// resourceOpsgenieIntegrationState enables or disables an integration, e.g.
+// to silence a noisy integration during an incident. With an expiry the
+// integration is enabled again by the first apply after it has passed. The
+// integration resources leave the state to this resource when their enabled
+// argument is in ignore_remote_changes.
+func resourceOpsgenieIntegrationState() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOpsgenieIntegrationStateCreate,
+		ReadContext:   resourceOpsgenieIntegrationStateRead,
+		UpdateContext: resourceOpsgenieIntegrationStateUpdate,
+		DeleteContext: resourceOpsgenieIntegrationStateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customizeOpsgenieIntegrationStateDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"integration_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"expires_at": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"expired": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// opsgenieIntegrationStateExpired tells whether the expiry of the state has
+// passed. A state without expiry never expires.
+func opsgenieIntegrationStateExpired(expiresAt string, now time.Time) bool {
+	if expiresAt == "" {
+		return false
+	}
+	expiry, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false
+	}
+	return !now.Before(expiry)
+}
+
+// customizeOpsgenieIntegrationStateDiff plans the integration to be enabled
+// again once the expiry has passed, as nothing else changes in the
+// configuration at that time.
+func customizeOpsgenieIntegrationStateDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChange("expires_at") {
+		return d.SetNewComputed("expired")
+	}
+	if d.Get("expired").(bool) {
+		return nil
+	}
+	if opsgenieIntegrationStateExpired(d.Get("expires_at").(string), time.Now()) {
+		return d.SetNew("expired", true)
+	}
+	return nil
+}
+
+func resourceOpsgenieIntegrationStateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	integrationId := d.Get("integration_id").(string)
+
+	if diags := applyOpsgenieIntegrationState(ctx, d, meta, integrationId); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(integrationId)
+
+	return readAfterCreate(ctx, d, meta, resourceOpsgenieIntegrationStateRead)
+}
+
+func resourceOpsgenieIntegrationStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	result, err := client.Get(ctx, &integration.GetRequest{
+		Id: d.Id(),
+	})
+	if err != nil {
+		return handleNonExistentResource(d, err)
+	}
+
+	enabled, _ := result.Data["enabled"].(bool)
+
+	d.Set("integration_id", d.Id())
+	if opsgenieIntegrationStateExpired(d.Get("expires_at").(string), time.Now()) {
+		// The configured state no longer applies, only whether the
+		// integration has been enabled again is of interest.
+		d.Set("expired", enabled)
+		return nil
+	}
+	d.Set("enabled", enabled)
+	d.Set("expired", false)
+
+	return nil
+}
+
+func resourceOpsgenieIntegrationStateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := applyOpsgenieIntegrationState(ctx, d, meta, d.Id()); diags.HasError() {
+		return diags
+	}
+
+	return resourceOpsgenieIntegrationStateRead(ctx, d, meta)
+}
+
+// resourceOpsgenieIntegrationStateDelete enables an integration which was
+// disabled by this resource, so that removing the resource ends the
+// override.
+func resourceOpsgenieIntegrationStateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("enabled").(bool) || d.Get("expired").(bool) {
+		return nil
+	}
+
+	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	log.Printf("[INFO] Enabling OpsGenie integration '%s' on removal of its state", d.Id())
+
+	_, err = client.Enable(ctx, &integration.EnableIntegrationRequest{
+		Id: d.Id(),
+	})
+	if err != nil && !isNotFoundError(err) {
+		return apiErrorDiagnostics(err)
+	}
+
+	return nil
+}
+
+func applyOpsgenieIntegrationState(ctx context.Context, d *schema.ResourceData, meta interface{}, integrationId string) diag.Diagnostics {
+	client, err := integration.NewClient(meta.(*OpsgenieClient).client.Config)
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	if opsgenieIntegrationStateExpired(d.Get("expires_at").(string), time.Now()) {
+		enabled = true
+	}
+
+	if enabled {
+		log.Printf("[INFO] Enabling OpsGenie integration '%s'", integrationId)
+		_, err = client.Enable(ctx, &integration.EnableIntegrationRequest{
+			Id: integrationId,
+		})
+	} else {
+		log.Printf("[INFO] Disabling OpsGenie integration '%s'", integrationId)
+		_, err = client.Disable(ctx, &integration.DisableIntegrationRequest{
+			Id: integrationId,
+		})
+	}
+	if err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	return nil
+}
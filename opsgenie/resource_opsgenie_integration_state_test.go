@@ -0,0 +1,101 @@
+package opsgenie
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/integration"
+)
+
+func TestAccOpsGenieIntegrationState_basic(t *testing.T) {
+	rs := acctest.RandString(6)
+	future := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testCheckOpsGenieApiIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsGenieIntegrationState_basic(rs, future),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOpsGenieIntegrationEnabled("opsgenie_api_integration.test", false),
+					resource.TestCheckResourceAttr("opsgenie_integration_state.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("opsgenie_integration_state.test", "expired", "false"),
+					resource.TestCheckResourceAttr("opsgenie_api_integration.test", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccOpsGenieIntegrationState_basic(rs, past),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOpsGenieIntegrationEnabled("opsgenie_api_integration.test", true),
+					resource.TestCheckResourceAttr("opsgenie_integration_state.test", "expired", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckOpsGenieIntegrationEnabled(name string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		client, err := integration.NewClient(testAccProvider.Meta().(*OpsgenieClient).client.Config)
+		if err != nil {
+			return err
+		}
+		result, err := client.Get(context.Background(), &integration.GetRequest{
+			Id: rs.Primary.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("Bad: Integration with id %q does not exist", rs.Primary.ID)
+		}
+		if result.Data["enabled"] != enabled {
+			return fmt.Errorf("Bad: expected integration %q to have enabled %t, got %v", rs.Primary.ID, enabled, result.Data["enabled"])
+		}
+		return nil
+	}
+}
+
+func testAccOpsGenieIntegrationState_basic(randomName, expiresAt string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_api_integration" "test" {
+  name                  = "genieintegration-state-%s"
+  type                  = "API"
+  enabled               = true
+  ignore_remote_changes = ["enabled"]
+}
+
+resource "opsgenie_integration_state" "test" {
+  integration_id = opsgenie_api_integration.test.id
+  enabled        = false
+  expires_at     = "%s"
+}
+`, randomName, expiresAt)
+}
+
+func TestOpsgenieIntegrationStateExpired(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]bool{
+		"":                          false,
+		"2024-05-01T11:00:00Z":      true,
+		"2024-05-01T12:00:00Z":      true,
+		"2024-05-01T13:00:00Z":      false,
+		"2024-05-01T13:30:00+02:00": true,
+		"not a date":                false,
+	}
+	for expiresAt, expected := range cases {
+		if actual := opsgenieIntegrationStateExpired(expiresAt, now); actual != expected {
+			t.Errorf("expected %q to be expired %t, got %t", expiresAt, expected, actual)
+		}
+	}
+}
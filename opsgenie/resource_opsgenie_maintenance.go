@@ -2,23 +2,30 @@ package opsgenie
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/opsgenie/opsgenie-go-sdk-v2/maintenance"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceOpsgenieMaintenance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsgenieMaintenanceCreate,
-		Read:   handleNonExistentResource(resourceOpsgenieMaintenanceRead),
-		Update: resourceOpsgenieMaintenanceUpdate,
-		Delete: resourceOpsgenieMaintenanceDelete,
+		CreateContext: resourceOpsgenieMaintenanceCreate,
+		ReadContext:   resourceOpsgenieMaintenanceRead,
+		UpdateContext: resourceOpsgenieMaintenanceUpdate,
+		DeleteContext: resourceOpsgenieMaintenanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"description": {
@@ -79,10 +86,10 @@ func resourceOpsgenieMaintenance() *schema.Resource {
 	}
 }
 
-func resourceOpsgenieMaintenanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieMaintenanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := maintenance.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	description := d.Get("description").(string)
 
@@ -94,24 +101,24 @@ func resourceOpsgenieMaintenanceCreate(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[INFO] Creating OpsGenie maintenance")
 
-	result, err := client.Create(context.Background(), createRequest)
+	result, err := client.Create(ctx, createRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(result.Id)
 
-	return resourceOpsgenieMaintenanceRead(d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsgenieMaintenanceRead)
 }
 
-func resourceOpsgenieMaintenanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieMaintenanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := maintenance.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
-	listResponse, err := client.List(context.Background(), &maintenance.ListRequest{})
+	listResponse, err := client.List(ctx, &maintenance.ListRequest{})
 	if err != nil {
-		return err
+		return handleNonExistentResource(d, err)
 	}
 
 	found := maintenance.GetResult{}
@@ -138,29 +145,29 @@ func resourceOpsgenieMaintenanceRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceOpsgenieMaintenanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieMaintenanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := maintenance.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
-	mnt, err := client.Get(context.Background(), &maintenance.GetRequest{
+	mnt, err := client.Get(ctx, &maintenance.GetRequest{
 		Id: d.Id(),
 	})
 	if err != nil {
 		log.Printf("[ERROR] Maintenance could not fetch")
-		return err
+		return apiErrorDiagnostics(err)
 
 	}
 	maintenanceTime := expandOpsgenieMaintenanceTime(d)
 	if mnt.Status == "active" {
 
-		_, err := client.ChangeEndDate(context.Background(), &maintenance.ChangeEndDateRequest{
+		_, err := client.ChangeEndDate(ctx, &maintenance.ChangeEndDateRequest{
 			Id:      d.Id(),
 			EndDate: maintenanceTime.EndDate,
 		})
 		if err != nil {
-			return err
+			return apiErrorDiagnostics(err)
 		}
 
 	} else if mnt.Status == "planned" {
@@ -175,33 +182,39 @@ func resourceOpsgenieMaintenanceUpdate(d *schema.ResourceData, meta interface{})
 
 		log.Printf("[INFO] Updating OpsGenie maintenance")
 
-		_, err = client.Update(context.Background(), updateRequest)
+		_, err = client.Update(ctx, updateRequest)
 		if err != nil {
 			log.Printf("%s", err.Error())
-			return err
+			return apiErrorDiagnostics(err)
 		}
 	} else {
 		log.Printf("[ERROR] You cannot edit past maintenance")
-		return errors.New("You cannot edit" + mnt.Status + " maintenances")
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("You cannot edit %s maintenances", mnt.Status),
+				Detail:   "Only planned maintenances can be updated, and active maintenances can only have their end date changed.",
+			},
+		}
 
 	}
 
 	return nil
 }
 
-func resourceOpsgenieMaintenanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieMaintenanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting OpsGenie escalation ")
 	client, err := maintenance.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	deleteRequest := &maintenance.DeleteRequest{
 		Id: d.Id(),
 	}
 
-	_, err = client.Delete(context.Background(), deleteRequest)
+	_, err = client.Delete(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
@@ -285,9 +298,15 @@ func expandOpsgenieMaintenanceTime(d *schema.ResourceData) maintenance.Time {
 
 func flattenMaintenanceTime(time maintenance.Time) []map[string]interface{} {
 	timeLayout := "2006-01-02T15:04:05Z"
-	return []map[string]interface{}{{
-		"type":       time.Type,
-		"start_date": time.StartDate.Format(timeLayout),
-		"end_date":   time.EndDate.Format(timeLayout),
-	}}
+	flattened := map[string]interface{}{
+		"type": time.Type,
+	}
+	// Maintenances that last indefinitely have no end date
+	if time.StartDate != nil {
+		flattened["start_date"] = time.StartDate.Format(timeLayout)
+	}
+	if time.EndDate != nil {
+		flattened["end_date"] = time.EndDate.Format(timeLayout)
+	}
+	return []map[string]interface{}{flattened}
 }
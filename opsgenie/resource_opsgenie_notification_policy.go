@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	ogClient "github.com/opsgenie/opsgenie-go-sdk-v2/client"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/policy"
 )
@@ -32,12 +33,12 @@ var (
 
 func resourceOpsGenieNotificationPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsGenieNotificationPolicyCreate,
-		Read:   handleNonExistentResource(resourceOpsGenieNotificationPolicyRead),
-		Update: resourceOpsGenieNotificationPolicyUpdate,
-		Delete: resourceOpsGenieNotificationPolicyDelete,
+		CreateContext: resourceOpsGenieNotificationPolicyCreate,
+		ReadContext:   resourceOpsGenieNotificationPolicyRead,
+		UpdateContext: resourceOpsGenieNotificationPolicyUpdate,
+		DeleteContext: resourceOpsGenieNotificationPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), "/")
 				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 					return nil, fmt.Errorf("Unexpected format of ID (%q), expected team_id/notification_policy_id", d.Id())
@@ -47,6 +48,12 @@ func resourceOpsGenieNotificationPolicy() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -291,10 +298,10 @@ func resourceOpsGenieNotificationPolicy() *schema.Resource {
 	}
 }
 
-func resourceOpsGenieNotificationPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieNotificationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := policy.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	suppress := d.Get("suppress").(bool)
@@ -317,36 +324,31 @@ func resourceOpsGenieNotificationPolicyCreate(d *schema.ResourceData, meta inter
 	}
 
 	log.Printf("[INFO] Creating Notification Policy '%s'", d.Get("name").(string))
-	result, err := client.CreateNotificationPolicy(context.Background(), createRequest)
+	result, err := client.CreateNotificationPolicy(ctx, createRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(result.Id)
 
-	return resourceOpsGenieNotificationPolicyRead(d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsGenieNotificationPolicyRead)
 }
 
-func resourceOpsGenieNotificationPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieNotificationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := policy.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 
 	log.Printf("[INFO] Reading OpsGenie Notification Policy '%s'", name)
 
-	policy, err := client.GetNotificationPolicy(context.Background(), &policy.GetNotificationPolicyRequest{
+	policy, err := client.GetNotificationPolicy(ctx, &policy.GetNotificationPolicyRequest{
 		Id:     d.Id(),
 		TeamId: d.Get("team_id").(string),
 	})
 	if err != nil {
-		x := err.(*ogClient.ApiError)
-		if x.StatusCode == 404 {
-			log.Printf("[WARN] Removing Notification Policy because it's gone %s", name)
-			d.SetId("")
-			return nil
-		}
+		return handleNonExistentResource(d, err)
 	}
 	d.Set("name", policy.Name)
 	d.Set("enabled", policy.Enabled)
@@ -390,10 +392,10 @@ func resourceOpsGenieNotificationPolicyRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceOpsGenieNotificationPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieNotificationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := policy.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	suppress := d.Get("suppress").(bool)
@@ -417,19 +419,19 @@ func resourceOpsGenieNotificationPolicyUpdate(d *schema.ResourceData, meta inter
 	}
 
 	log.Printf("[INFO] Updating Notification Policy '%s'", d.Get("name").(string))
-	_, err = client.UpdateNotificationPolicy(context.Background(), updateRequest)
+	_, err = client.UpdateNotificationPolicy(ctx, updateRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
 }
 
-func resourceOpsGenieNotificationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieNotificationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting OpsGenie Notification Policy '%s'", d.Get("name").(string))
 	client, err := policy.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	deleteRequest := &policy.DeletePolicyRequest{
 		Id:     d.Id(),
@@ -437,9 +439,9 @@ func resourceOpsGenieNotificationPolicyDelete(d *schema.ResourceData, meta inter
 		Type:   "notification",
 	}
 
-	_, err = client.DeletePolicy(context.Background(), deleteRequest)
+	_, err = client.DeletePolicy(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	return nil
 }
@@ -5,22 +5,23 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	ogClient "github.com/opsgenie/opsgenie-go-sdk-v2/client"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/notification"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
 )
 
 func resourceOpsGenieNotificationRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsGenieNotificationRuleCreate,
-		Read:   resourceOpsGenieNotificationRuleRead,
-		Update: resourceOpsGenieNotificationRuleUpdate,
-		Delete: resourceOpsGenieNotificationRuleDelete,
+		CreateContext: resourceOpsGenieNotificationRuleCreate,
+		ReadContext:   resourceOpsGenieNotificationRuleRead,
+		UpdateContext: resourceOpsGenieNotificationRuleUpdate,
+		DeleteContext: resourceOpsGenieNotificationRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), "/")
 				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 					return nil, fmt.Errorf("Unexpected format of ID (%q), expected username/notification_rule_id", d.Id())
@@ -30,6 +31,12 @@ func resourceOpsGenieNotificationRule() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -254,10 +261,10 @@ func resourceOpsGenieNotificationRule() *schema.Resource {
 	}
 }
 
-func resourceOpsGenieNotificationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieNotificationRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := notification.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	enabled := d.Get("enabled").(bool)
@@ -293,37 +300,32 @@ func resourceOpsGenieNotificationRuleCreate(d *schema.ResourceData, meta interfa
 	}
 
 	log.Printf("[INFO] Creating Notification Rule '%s' for User: '%s'", d.Get("name").(string), d.Get("username").(string))
-	result, err := client.CreateRule(context.Background(), createRequest)
+	result, err := client.CreateRule(ctx, createRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(result.SimpleNotificationRule.Id)
 
-	return resourceOpsGenieNotificationRuleRead(d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsGenieNotificationRuleRead)
 }
 
-func resourceOpsGenieNotificationRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieNotificationRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := notification.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 	username := d.Get("username").(string)
 
 	log.Printf("[INFO] Reading OpsGenie Notification Rule '%s' for user '%s'", name, username)
 
-	rule, err := client.GetRule(context.Background(), &notification.GetRuleRequest{
+	rule, err := client.GetRule(ctx, &notification.GetRuleRequest{
 		UserIdentifier: username,
 		RuleId:         d.Id(),
 	})
 	if err != nil {
-		x := err.(*ogClient.ApiError)
-		if x.StatusCode == 404 {
-			log.Printf("[WARN] Removing Notification Rule because it's gone %s", name)
-			d.SetId("")
-			return nil
-		}
+		return handleNonExistentResource(d, err)
 	}
 
 	if rule.Schedules != nil {
@@ -350,10 +352,10 @@ func resourceOpsGenieNotificationRuleRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceOpsGenieNotificationRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieNotificationRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := notification.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	enabled := d.Get("enabled").(bool)
@@ -388,30 +390,30 @@ func resourceOpsGenieNotificationRuleUpdate(d *schema.ResourceData, meta interfa
 	}
 
 	log.Printf("[INFO] Updating Notification Rule '%s' for User: '%s'", d.Get("name").(string), d.Get("username").(string))
-	result, err := client.UpdateRule(context.Background(), updateRequest)
+	result, err := client.UpdateRule(ctx, updateRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(result.SimpleNotificationRule.Id)
 
-	return resourceOpsGenieNotificationRuleRead(d, meta)
+	return resourceOpsGenieNotificationRuleRead(ctx, d, meta)
 }
 
-func resourceOpsGenieNotificationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieNotificationRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting OpsGenie Notification Rule '%s' for user '%s'", d.Get("name").(string), d.Get("username").(string))
 	client, err := notification.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	deleteRequest := &notification.DeleteRuleRequest{
 		UserIdentifier: d.Get("username").(string),
 		RuleId:         d.Id(),
 	}
 
-	_, err = client.DeleteRule(context.Background(), deleteRequest)
+	_, err = client.DeleteRule(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	return nil
 }
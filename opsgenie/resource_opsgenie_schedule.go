@@ -11,17 +11,24 @@ import (
 
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceOpsgenieSchedule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsgenieScheduleCreate,
-		Read:   handleNonExistentResource(resourceOpsgenieScheduleRead),
-		Update: resourceOpsgenieScheduleUpdate,
-		Delete: resourceOpsgenieScheduleDelete,
+		CreateContext: resourceOpsgenieScheduleCreate,
+		ReadContext:   resourceOpsgenieScheduleRead,
+		UpdateContext: resourceOpsgenieScheduleUpdate,
+		DeleteContext: resourceOpsgenieScheduleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -67,10 +74,10 @@ func checkTimeZoneDifference(k, old, new string, d *schema.ResourceData) bool {
 	return timeOld.Format(time.ANSIC) == timeNew.Format(time.ANSIC)
 }
 
-func resourceOpsgenieScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := schedule.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -92,20 +99,20 @@ func resourceOpsgenieScheduleCreate(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[INFO] Creating OpsGenie schedule '%s'", name)
 
-	result, err := client.Create(context.Background(), createRequest)
+	result, err := client.Create(ctx, createRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(result.Id)
 
-	return resourceOpsgenieScheduleRead(d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsgenieScheduleRead)
 }
 
-func resourceOpsgenieScheduleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := schedule.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	getRequest := &schedule.GetRequest{
@@ -113,9 +120,9 @@ func resourceOpsgenieScheduleRead(d *schema.ResourceData, meta interface{}) erro
 		IdentifierValue: d.Id(),
 	}
 
-	getResponse, err := client.Get(context.Background(), getRequest)
+	getResponse, err := client.Get(ctx, getRequest)
 	if err != nil {
-		return err
+		return handleNonExistentResource(d, err)
 	}
 
 	d.Set("name", getResponse.Schedule.Name)
@@ -129,10 +136,10 @@ func resourceOpsgenieScheduleRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceOpsgenieScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := schedule.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -158,28 +165,29 @@ func resourceOpsgenieScheduleUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 	log.Printf("[INFO] Updating OpsGenie schedule '%s'", name)
 
-	_, err = client.Update(context.Background(), updateRequest)
+	_, err = client.Update(ctx, updateRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
 }
 
-func resourceOpsgenieScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting OpsGenie schedule '%s'", d.Get("name").(string))
 	client, err := schedule.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	deleteRequest := &schedule.DeleteRequest{
 		IdentifierType:  schedule.Id,
 		IdentifierValue: d.Id(),
 	}
 
-	_, err = client.Delete(context.Background(), deleteRequest)
+	recordOpsGenieUserReferencesChanged(d.Id())
+	_, err = client.Delete(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
@@ -10,17 +10,18 @@ import (
 	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/schedule"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceOpsgenieScheduleRotation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsgenieScheduleRotationCreate,
-		Read:   handleNonExistentResource(resourceOpsgenieScheduleRotationRead),
-		Update: resourceOpsgenieScheduleRotationUpdate,
-		Delete: resourceOpsgenieScheduleRotationDelete,
+		CreateContext: resourceOpsgenieScheduleRotationCreate,
+		ReadContext:   resourceOpsgenieScheduleRotationRead,
+		UpdateContext: resourceOpsgenieScheduleRotationUpdate,
+		DeleteContext: resourceOpsgenieScheduleRotationDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), "/")
 				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 					return nil, fmt.Errorf("Unexpected format of ID (%q), expected schedule_id/schedule_rotation_id", d.Id())
@@ -32,6 +33,12 @@ func resourceOpsgenieScheduleRotation() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"schedule_id": {
 				Type:     schema.TypeString,
@@ -160,10 +167,10 @@ func resourceOpsgenieScheduleRotation() *schema.Resource {
 	}
 }
 
-func resourceOpsgenieScheduleRotationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieScheduleRotationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := schedule.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	scheduleIdentiferValue := d.Get("schedule_id").(string)
 
@@ -177,7 +184,7 @@ func resourceOpsgenieScheduleRotationCreate(d *schema.ResourceData, meta interfa
 	layoutStr := "2006-01-02T15:04:05Z"
 	startDate, err := time.Parse(layoutStr, start_date)
 	if err != nil {
-		return fmt.Errorf("Cannot parse date-time")
+		return diag.Diagnostics{attributeDiagnostic("start_date", "Cannot parse date-time", err)}
 	}
 
 	createRequest := &schedule.CreateRotationRequest{
@@ -197,7 +204,7 @@ func resourceOpsgenieScheduleRotationCreate(d *schema.ResourceData, meta interfa
 	if end_date != "" {
 		endDate, err := time.Parse(layoutStr, end_date)
 		if err != nil {
-			log.Fatal(err)
+			return diag.Diagnostics{attributeDiagnostic("end_date", "Cannot parse date-time", err)}
 		}
 		createRequest.Rotation.EndDate = &endDate
 	}
@@ -210,20 +217,20 @@ func resourceOpsgenieScheduleRotationCreate(d *schema.ResourceData, meta interfa
 
 	log.Printf("[INFO] Creating OpsGenie rotation '%s'", name)
 
-	result, err := client.CreateRotation(context.Background(), createRequest)
+	result, err := client.CreateRotation(ctx, createRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(result.Id)
 
-	return resourceOpsgenieScheduleRotationRead(d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsgenieScheduleRotationRead)
 }
 
-func resourceOpsgenieScheduleRotationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieScheduleRotationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := schedule.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	scheduleIdentiferValue := d.Get("schedule_id").(string)
@@ -233,9 +240,9 @@ func resourceOpsgenieScheduleRotationRead(d *schema.ResourceData, meta interface
 		ScheduleIdentifierValue: scheduleIdentiferValue,
 		RotationId:              d.Id(),
 	}
-	getResponse, err := client.GetRotation(context.Background(), getRequest)
+	getResponse, err := client.GetRotation(ctx, getRequest)
 	if err != nil {
-		return err
+		return handleNonExistentResource(d, err)
 	}
 	startDate := getResponse.StartDate.Format("2006-01-02T15:04:05Z")
 	d.SetId(getResponse.Rotation.Id)
@@ -310,10 +317,10 @@ func flattenOpsgenieScheduleRotationTimeRestriction(input *og.TimeRestriction) [
 	return output
 }
 
-func resourceOpsgenieScheduleRotationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieScheduleRotationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := schedule.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	scheduleIdentiferValue := d.Get("schedule_id").(string)
 
@@ -327,7 +334,7 @@ func resourceOpsgenieScheduleRotationUpdate(d *schema.ResourceData, meta interfa
 	layoutStr := "2006-01-02T15:04:05Z"
 	startDate, err := time.Parse(layoutStr, start_date)
 	if err != nil {
-		log.Fatal(err)
+		return diag.Diagnostics{attributeDiagnostic("start_date", "Cannot parse date-time", err)}
 	}
 
 	updateRequest := &schedule.UpdateRotationRequest{
@@ -348,7 +355,7 @@ func resourceOpsgenieScheduleRotationUpdate(d *schema.ResourceData, meta interfa
 	if end_date != "" {
 		endDate, err := time.Parse(layoutStr, end_date)
 		if err != nil {
-			log.Fatal(err)
+			return diag.Diagnostics{attributeDiagnostic("end_date", "Cannot parse date-time", err)}
 		}
 		updateRequest.Rotation.EndDate = &endDate
 	}
@@ -357,19 +364,20 @@ func resourceOpsgenieScheduleRotationUpdate(d *schema.ResourceData, meta interfa
 	}
 	log.Printf("[INFO] Updating OpsGenie schedule rotation '%s'", name)
 
-	_, err = client.UpdateRotation(context.Background(), updateRequest)
+	recordOpsGenieUserReferencesChanged(scheduleIdentiferValue)
+	_, err = client.UpdateRotation(ctx, updateRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
 }
 
-func resourceOpsgenieScheduleRotationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsgenieScheduleRotationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting OpsGenie schedule rotation '%s'", d.Get("name").(string))
 	client, err := schedule.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	scheduleIdentiferValue := d.Get("schedule_id").(string)
 
@@ -379,9 +387,10 @@ func resourceOpsgenieScheduleRotationDelete(d *schema.ResourceData, meta interfa
 		RotationId:              d.Id(),
 	}
 
-	_, err = client.DeleteRotation(context.Background(), deleteRequest)
+	recordOpsGenieUserReferencesChanged(scheduleIdentiferValue)
+	_, err = client.DeleteRotation(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
@@ -3,7 +3,9 @@ package opsgenie
 import (
 	"context"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
@@ -12,12 +14,18 @@ import (
 
 func resourceOpsGenieServiceAudienceTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsGenieServiceAudienceTemplateUpdate,
-		Read:   handleNonExistentResource(resourceOpsGenieServiceAudienceTemplateRead),
-		Update: resourceOpsGenieServiceAudienceTemplateUpdate,
-		Delete: resourceOpsGenieServiceAudienceTemplateDelete,
+		CreateContext: resourceOpsGenieServiceAudienceTemplateCreate,
+		ReadContext:   resourceOpsGenieServiceAudienceTemplateRead,
+		UpdateContext: resourceOpsGenieServiceAudienceTemplateUpdate,
+		DeleteContext: resourceOpsGenieServiceAudienceTemplateDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -109,20 +117,20 @@ func resourceOpsGenieServiceAudienceTemplate() *schema.Resource {
 	}
 }
 
-func resourceOpsGenieServiceAudienceTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieServiceAudienceTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := service.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	service_id := d.Get("service_id").(string)
 
 	log.Printf("[INFO] Reading OpsGenie Service Audience Template for service: '%s'", service_id)
 
-	audience_template, err := client.GetAudienceTemplate(context.Background(), &service.GetAudienceTemplateRequest{
+	audience_template, err := client.GetAudienceTemplate(ctx, &service.GetAudienceTemplateRequest{
 		ServiceId: service_id,
 	})
 	if err != nil {
-		return err
+		return handleNonExistentResource(d, err)
 	}
 
 	d.Set("service_id", service_id)
@@ -133,7 +141,26 @@ func resourceOpsGenieServiceAudienceTemplateRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceOpsGenieServiceAudienceTemplateUpdate(input *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieServiceAudienceTemplateCreate(ctx context.Context, input *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := updateOpsGenieServiceAudienceTemplate(ctx, input, meta); err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	// the audience template belongs to its service and has no id of its own
+	input.SetId(input.Get("service_id").(string))
+
+	return readAfterCreate(ctx, input, meta, resourceOpsGenieServiceAudienceTemplateRead)
+}
+
+func resourceOpsGenieServiceAudienceTemplateUpdate(ctx context.Context, input *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := updateOpsGenieServiceAudienceTemplate(ctx, input, meta); err != nil {
+		return apiErrorDiagnostics(err)
+	}
+
+	return resourceOpsGenieServiceAudienceTemplateRead(ctx, input, meta)
+}
+
+func updateOpsGenieServiceAudienceTemplate(ctx context.Context, input *schema.ResourceData, meta interface{}) error {
 	client, err := service.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
 		return err
@@ -163,18 +190,18 @@ func resourceOpsGenieServiceAudienceTemplateUpdate(input *schema.ResourceData, m
 	}
 
 	log.Printf("[INFO] Updating OpsGenie Service Audience Template for service '%s'", input.Get("service_id").(string))
-	_, err = client.UpdateAudienceTemplate(context.Background(), updateRequest)
+	_, err = client.UpdateAudienceTemplate(ctx, updateRequest)
 	if err != nil {
 		return err
 	}
 
-	return resourceOpsGenieServiceAudienceTemplateRead(input, meta)
+	return nil
 }
 
-func resourceOpsGenieServiceAudienceTemplateDelete(input *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieServiceAudienceTemplateDelete(ctx context.Context, input *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := service.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	// delete updates with nil values
@@ -186,9 +213,9 @@ func resourceOpsGenieServiceAudienceTemplateDelete(input *schema.ResourceData, m
 	}
 
 	log.Printf("[INFO] Deleting OpsGenie Service Audience Template for service '%s'", input.Get("service_id").(string))
-	_, err = client.UpdateAudienceTemplate(context.Background(), updateRequest)
+	_, err = client.UpdateAudienceTemplate(ctx, updateRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
@@ -273,20 +300,31 @@ func flattenOpsgenieServiceAudienceTemplate(input *service.GetAudienceTemplateRe
 func flattenOpsgenieServiceAudienceTemplateStakeholder(input service.StakeholderOfAudience) []map[string]interface{} {
 	stakeholder := make([]map[string]interface{}, 0, 1)
 	out := make(map[string]interface{})
-	if len(input.Conditions) > 0{
-		out["conditions"] = input.Conditions
+	if len(input.Conditions) > 0 {
+		conditions := make([]map[string]interface{}, 0, len(input.Conditions))
+		for _, v := range input.Conditions {
+			conditions = append(conditions, map[string]interface{}{
+				"match_field": string(v.MatchField),
+				"key":         v.Key,
+				"value":       v.Value,
+			})
+		}
+		out["conditions"] = conditions
 	}
 	if len(input.ConditionMatchType) > 0 {
+		out["condition_match_type"] = string(input.ConditionMatchType)
+	}
+	if len(input.Individuals) > 0 {
 		out["individuals"] = input.Individuals
 	}
 	stakeholder = append(stakeholder, out)
-	return stakeholder	
+	return stakeholder
 }
 
 func flattenOpsgenieServiceAudienceTemplateResponder(input service.ResponderOfAudience) []map[string]interface{} {
 	responder := make([]map[string]interface{}, 0, 1)
 	out := make(map[string]interface{})
-	if len(input.Teams) > 0{
+	if len(input.Teams) > 0 {
 		out["teams"] = input.Teams
 	}
 	if len(input.Individuals) > 0 {
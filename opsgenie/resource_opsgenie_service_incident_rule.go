@@ -7,21 +7,22 @@ import (
 	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	ogClient "github.com/opsgenie/opsgenie-go-sdk-v2/client"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/service"
 )
 
 func resourceOpsGenieServiceIncidentRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsGenieServiceIncidentRuleCreate,
-		Read:   handleNonExistentResource(resourceOpsGenieServiceIncidentRuleRead),
-		Update: resourceOpsGenieServiceIncidentRuleUpdate,
-		Delete: resourceOpsGenieServiceIncidentRuleDelete,
+		CreateContext: resourceOpsGenieServiceIncidentRuleCreate,
+		ReadContext:   resourceOpsGenieServiceIncidentRuleRead,
+		UpdateContext: resourceOpsGenieServiceIncidentRuleUpdate,
+		DeleteContext: resourceOpsGenieServiceIncidentRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), "/")
 				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 					return nil, fmt.Errorf("Unexpected format of ID (%q), expected service_id/service_incident_rule_id", d.Id())
@@ -31,6 +32,12 @@ func resourceOpsGenieServiceIncidentRule() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"service_id": {
 				Type:         schema.TypeString,
@@ -157,10 +164,10 @@ func resourceOpsGenieServiceIncidentRule() *schema.Resource {
 	}
 }
 
-func resourceOpsGenieServiceIncidentRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieServiceIncidentRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := service.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	service_id := d.Get("service_id").(string)
@@ -177,51 +184,50 @@ func resourceOpsGenieServiceIncidentRuleCreate(d *schema.ResourceData, meta inte
 	}
 
 	log.Printf("[INFO] Creating OpsGenie Service Incident Rule for service '%s'", d.Get("service_id").(string))
-	result, err := client.CreateIncidentRule(context.Background(), createRequest)
+	result, err := client.CreateIncidentRule(ctx, createRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(result.Id)
 
-	return resourceOpsGenieServiceIncidentRuleRead(d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsGenieServiceIncidentRuleRead)
 }
 
-func resourceOpsGenieServiceIncidentRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieServiceIncidentRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := service.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	service_id := d.Get("service_id").(string)
 	incident_rule_id := d.Id()
 
 	log.Printf("[INFO] Reading OpsGenie Service Incident Rule for service: '%s' for rule ID: '%s'", service_id, incident_rule_id)
 
-	incident_rule_res, err := client.GetIncidentRules(context.Background(), &service.GetIncidentRulesRequest{
+	incident_rule_res, err := client.GetIncidentRules(ctx, &service.GetIncidentRulesRequest{
 		ServiceId: service_id,
 	})
 	if err != nil {
-		x := err.(*ogClient.ApiError)
-		if x.StatusCode == 404 {
-			log.Printf("[WARN] Removing Service Incident Rule because it's gone %s", service_id)
-			d.SetId("")
-			return nil
-		}
+		return handleNonExistentResource(d, err)
 	}
 
 	for _, v := range incident_rule_res.IncidentRule {
 		if v.Id == incident_rule_id {
 			d.Set("service_id", service_id)
 			d.Set("incident_rule", flattenOpsGenieServiceIncidentRules(v))
+			return nil
 		}
 	}
+
+	log.Printf("[WARN] Removing Service Incident Rule because it's gone %s", incident_rule_id)
+	d.SetId("")
 	return nil
 }
 
-func resourceOpsGenieServiceIncidentRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieServiceIncidentRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := service.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	service_id := d.Get("service_id").(string)
@@ -241,31 +247,31 @@ func resourceOpsGenieServiceIncidentRuleUpdate(d *schema.ResourceData, meta inte
 	}
 
 	log.Printf("[INFO] Updating Service Incident Rule for service: '%s' for rule ID: '%s'", service_id, incident_rule_id)
-	_, err = client.UpdateIncidentRule(context.Background(), updateRequest)
+	_, err = client.UpdateIncidentRule(ctx, updateRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
 }
 
-func resourceOpsGenieServiceIncidentRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieServiceIncidentRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	service_id := d.Get("service_id").(string)
 	incident_rule_id := d.Id()
 
 	log.Printf("[INFO] Deleting OpsGenie ervice Incident Rule for service: '%s' for rule ID: '%s'", service_id, incident_rule_id)
 	client, err := service.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	deleteRequest := &service.DeleteIncidentRuleRequest{
 		ServiceId:      service_id,
 		IncidentRuleId: incident_rule_id,
 	}
 
-	_, err = client.DeleteIncidentRule(context.Background(), deleteRequest)
+	_, err = client.DeleteIncidentRule(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	return nil
 }
@@ -380,7 +386,7 @@ func flattenOpsGenieServiceIncidentRuleConditions(input og.Condition) map[string
 
 	condition["field"] = input.Field
 	condition["operation"] = input.Operation
-	condition["not"] = *input.IsNot
+	condition["not"] = input.IsNot != nil && *input.IsNot
 	condition["expected_value"] = input.ExpectedValue
 	if input.Key != "" {
 		condition["key"] = input.Key
@@ -409,7 +415,7 @@ func flattenOpsGenieServiceIncidentRuleIncidentProperties(input service.Incident
 func flattenOpsGenieServiceIncidentRuleStakeholderProperties(input service.StakeholderProperties) []map[string]interface{} {
 	stakeholders_properties := make(map[string]interface{})
 
-	stakeholders_properties["enable"] = *input.Enable
+	stakeholders_properties["enable"] = input.Enable == nil || *input.Enable
 	stakeholders_properties["message"] = input.Message
 	if input.Description != "" {
 		stakeholders_properties["description"] = input.Description
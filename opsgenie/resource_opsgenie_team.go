@@ -2,27 +2,62 @@ package opsgenie
 
 import (
 	"context"
-	"errors"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/escalation"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/schedule"
 	"log"
+	"time"
 
 	"fmt"
 	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/team"
 )
 
+const (
+	teamMembershipModeAuthoritative = "authoritative"
+	teamMembershipModeAdditive      = "additive"
+	teamMembershipModeIgnore        = "ignore"
+
+	teamDefaultResourcesKeep   = "keep"
+	teamDefaultResourcesDelete = "delete"
+	teamDefaultResourcesAdopt  = "adopt"
+)
+
+// teamDefaultsSettleTimeout bounds how long a create waits for the default
+// routing rule, escalation and schedule of a new team, which OpsGenie creates
+// asynchronously.
+const teamDefaultsSettleTimeout = time.Minute
+
 func resourceOpsGenieTeam() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsGenieTeamCreate,
-		Read:   handleNonExistentResource(resourceOpsGenieTeamRead),
-		Update: resourceOpsGenieTeamUpdate,
-		Delete: resourceOpsGenieTeamDelete,
+		CreateContext: resourceOpsGenieTeamCreate,
+		ReadContext:   resourceOpsGenieTeamRead,
+		UpdateContext: resourceOpsGenieTeamUpdate,
+		DeleteContext: resourceOpsGenieTeamDelete,
+		CustomizeDiff: customizeOpsGenieTeamDiff,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceOpsGenieTeamV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceOpsGenieTeamStateUpgradeV0,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -35,24 +70,55 @@ func resourceOpsGenieTeam() *schema.Resource {
 				Optional: true,
 			},
 			"ignore_members": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:       schema.TypeBool,
+				Optional:   true,
+				Deprecated: "Use membership_mode = \"ignore\" instead",
+			},
+			"membership_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      teamMembershipModeAuthoritative,
+				ValidateFunc: validation.StringInSlice([]string{teamMembershipModeAuthoritative, teamMembershipModeAdditive, teamMembershipModeIgnore}, false),
 			},
 			"delete_default_resources": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Deprecated:    "Use default_resources_mode = \"delete\" instead",
+				ConflictsWith: []string{"default_resources_mode"},
+			},
+			"default_resources_mode": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{teamDefaultResourcesKeep, teamDefaultResourcesDelete, teamDefaultResourcesAdopt}, false),
+				ConflictsWith: []string{"delete_default_resources"},
+			},
+			"default_routing_rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_escalation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_schedule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"member": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
+				Set:      hashOpsGenieTeamMember,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
 						},
-
 						"role": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -65,10 +131,10 @@ func resourceOpsGenieTeam() *schema.Resource {
 	}
 }
 
-func resourceOpsGenieTeamCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieTeamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := team.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -78,54 +144,60 @@ func resourceOpsGenieTeamCreate(d *schema.ResourceData, meta interface{}) error
 		Description: description,
 	}
 
-	if len(d.Get("member").([]interface{})) > 0 && !d.Get("ignore_members").(bool) {
-		createRequest.Members = expandOpsGenieTeamMembers(d)
+	if opsGenieTeamMembershipMode(d) != teamMembershipModeIgnore {
+		members, err := expandOpsGenieTeamMembers(d.Get("member").(*schema.Set).List())
+		if err != nil {
+			return diag.Diagnostics{attributeDiagnostic("member", "Invalid team member", err)}
+		}
+		if len(members) > 0 {
+			createRequest.Members = members
+		}
 	}
 
 	log.Printf("[INFO] Creating OpsGenie team %q", name)
 
-	_, err = client.Create(context.Background(), createRequest)
-	if err != nil {
-		return err
-	}
-
-	getRequest := &team.GetTeamRequest{
-		IdentifierType:  team.Name,
-		IdentifierValue: name,
-	}
-
-	getResponse, err := client.Get(context.Background(), getRequest)
+	result, err := client.Create(ctx, createRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
-	d.SetId(getResponse.Id)
+	d.SetId(result.Id)
 
-	shouldDeleteDefaultResources := d.Get("delete_default_resources").(bool)
+	switch opsGenieTeamDefaultResourcesMode(d) {
+	case teamDefaultResourcesDelete:
+		defaults, err := waitForOpsGenieTeamDefaults(ctx, result.Id, meta.(*OpsgenieClient).client.Config)
+		if err != nil {
+			return diag.Diagnostics{attributeDiagnostic("default_resources_mode", "Failed to find default resources of team", err)}
+		}
 
-	if shouldDeleteDefaultResources {
-		err = findAndUpdateDefaultRoutingRule(name, meta.(*OpsgenieClient).client.Config)
+		err = updateDefaultRoutingRules(ctx, result.Id, meta.(*OpsgenieClient).client.Config)
 		if err != nil {
-			return err
+			return diag.Diagnostics{attributeDiagnostic("default_resources_mode", "Failed to update default routing rule of team", err)}
 		}
 
-		err := findAndDeleteDefaultEscalation(name, meta.(*OpsgenieClient).client.Config)
+		err = deleteDefaultEscalation(ctx, defaults.escalationId, meta.(*OpsgenieClient).client.Config)
 		if err != nil {
-			return err
+			return diag.Diagnostics{attributeDiagnostic("default_resources_mode", "Failed to delete default escalation of team", err)}
 		}
 
-		err = findAndDeleteDefaultSchedule(name, meta.(*OpsgenieClient).client.Config)
+		err = deleteDefaultSchedule(ctx, defaults.scheduleId, meta.(*OpsgenieClient).client.Config)
 		if err != nil {
-			return err
+			return diag.Diagnostics{attributeDiagnostic("default_resources_mode", "Failed to delete default schedule of team", err)}
 		}
+	case teamDefaultResourcesAdopt:
+		defaults, err := waitForOpsGenieTeamDefaults(ctx, result.Id, meta.(*OpsgenieClient).client.Config)
+		if err != nil {
+			return diag.Diagnostics{attributeDiagnostic("default_resources_mode", "Failed to find default resources of team", err)}
+		}
+		defaults.set(d)
 	}
-	return resourceOpsGenieTeamRead(d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsGenieTeamRead)
 }
 
-func resourceOpsGenieTeamRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieTeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := team.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	getRequest := &team.GetTeamRequest{
@@ -135,25 +207,33 @@ func resourceOpsGenieTeamRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] Retrieving state of OpsGenie team '%s'", d.Get("name"))
 
-	getResponse, err := client.Get(context.Background(), getRequest)
+	getResponse, err := client.Get(ctx, getRequest)
 	if err != nil {
-		return err
+		return handleNonExistentResource(d, err)
 	}
 
 	d.Set("name", getResponse.Name)
 	d.Set("description", getResponse.Description)
 
-	if !d.Get("ignore_members").(bool) {
-		d.Set("member", flattenOpsGenieTeamMembers(getResponse.Members))
+	diags := readOpsGenieTeamDefaults(ctx, d, meta)
+	if diags.HasError() {
+		return diags
 	}
 
-	return nil
+	switch opsGenieTeamMembershipMode(d) {
+	case teamMembershipModeAuthoritative:
+		d.Set("member", flattenOpsGenieTeamResourceMembers(getResponse.Members, d.Get("member").(*schema.Set).List(), false))
+	case teamMembershipModeAdditive:
+		d.Set("member", flattenOpsGenieTeamResourceMembers(getResponse.Members, d.Get("member").(*schema.Set).List(), true))
+	}
+
+	return diags
 }
 
-func resourceOpsGenieTeamUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieTeamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := team.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
@@ -164,34 +244,65 @@ func resourceOpsGenieTeamUpdate(d *schema.ResourceData, meta interface{}) error
 		Description: description,
 	}
 
-	if len(d.Get("member").([]interface{})) > 0 && !d.Get("ignore_members").(bool) {
-		updateRequest.Members = expandOpsGenieTeamMembers(d)
+	mode := opsGenieTeamMembershipMode(d)
+	members, err := expandOpsGenieTeamMembers(d.Get("member").(*schema.Set).List())
+	if err != nil {
+		return diag.Diagnostics{attributeDiagnostic("member", "Invalid team member", err)}
+	}
+	if mode == teamMembershipModeAuthoritative && len(members) > 0 {
+		updateRequest.Members = members
 	}
 
 	log.Printf("[INFO] Updating OpsGenie team '%s'", name)
 
-	_, err = client.Update(context.Background(), updateRequest)
+	_, err = client.Update(ctx, updateRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
+	}
+	if d.HasChange("member") {
+		recordOpsGenieUserReferencesChanged(d.Id())
 	}
 
-	return nil
+	diags := readOpsGenieTeamDefaults(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !d.HasChange("member") {
+		return diags
+	}
+
+	// The update API ignores an empty member list and only ever replaces the
+	// whole membership, so removals and additive changes go member by member
+	oldMembers, newMembers := d.GetChange("member")
+	switch {
+	case mode == teamMembershipModeAuthoritative && len(members) == 0:
+		err = applyOpsGenieTeamMemberChanges(ctx, client, d.Id(), oldMembers.(*schema.Set).List(), nil)
+	case mode == teamMembershipModeAdditive:
+		err = applyOpsGenieTeamMemberChanges(ctx, client, d.Id(), oldMembers.(*schema.Set).List(), newMembers.(*schema.Set).List())
+	}
+	if err != nil {
+		return append(diags, apiErrorDiagnostics(err)...)
+	}
+
+	return diags
 }
 
-func resourceOpsGenieTeamDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieTeamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting OpsGenie team '%s'", d.Get("name").(string))
 	client, err := team.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	deleteRequest := &team.DeleteTeamRequest{
 		IdentifierType:  team.Id,
 		IdentifierValue: d.Id(),
 	}
 
-	_, err = client.Delete(context.Background(), deleteRequest)
+	recordOpsGenieUserReferencesChanged(d.Id())
+	_, err = client.Delete(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
@@ -209,33 +320,145 @@ func flattenOpsGenieTeamMembers(input []team.Member) []map[string]interface{} {
 	return members
 }
 
-func expandOpsGenieTeamMembers(d *schema.ResourceData) []team.Member {
-	input := d.Get("member").([]interface{})
+func expandOpsGenieTeamMembers(input []interface{}) ([]team.Member, error) {
 	members := make([]team.Member, 0, len(input))
+	for _, v := range input {
+		member, err := expandOpsGenieTeamMember(v.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
 
-	if input == nil {
-		return members
+func expandOpsGenieTeamMember(config map[string]interface{}) (team.Member, error) {
+	userId := config["id"].(string)
+	username := config["username"].(string)
+	if (userId == "") == (username == "") {
+		return team.Member{}, fmt.Errorf("exactly one of id or username must be set for each member, got id %q and username %q", userId, username)
 	}
 
-	for _, v := range input {
+	return team.Member{
+		User: team.User{
+			ID:       userId,
+			Username: username,
+		},
+		Role: config["role"].(string),
+	}, nil
+}
+
+// teamMemberKey identifies the user of a member block, by username if it is
+// given and by ID otherwise. Usernames are matched case-insensitively, like
+// Opsgenie does.
+func teamMemberKey(config map[string]interface{}) string {
+	if username, _ := config["username"].(string); username != "" {
+		return "username:" + strings.ToLower(username)
+	}
+	id, _ := config["id"].(string)
+	return "id:" + strings.ToLower(id)
+}
+
+func hashOpsGenieTeamMember(v interface{}) int {
+	return schema.HashString(teamMemberKey(v.(map[string]interface{})))
+}
+
+func opsGenieTeamMembershipMode(d *schema.ResourceData) string {
+	if d.Get("ignore_members").(bool) {
+		return teamMembershipModeIgnore
+	}
+	return d.Get("membership_mode").(string)
+}
+
+// flattenOpsGenieTeamResourceMembers refers to each member the same way as
+// the configuration does, by username or by ID, so that the set does not
+// change between plans. Members which are not declared are reported by ID,
+// unless onlyDeclared is set for the additive membership mode.
+func flattenOpsGenieTeamResourceMembers(input []team.Member, declared []interface{}, onlyDeclared bool) []interface{} {
+	declaredKeys := make(map[string]map[string]interface{}, len(declared))
+	for _, v := range declared {
 		config := v.(map[string]interface{})
+		declaredKeys[teamMemberKey(config)] = config
+	}
 
-		userId := config["id"].(string)
-		role := config["role"].(string)
+	members := make([]interface{}, 0, len(input))
+	for _, m := range input {
+		byUsername := map[string]interface{}{"username": m.User.Username}
+		byId := map[string]interface{}{"id": m.User.ID}
 
-		member := team.Member{
-			User: team.User{
-				ID: userId,
-			},
-			Role: role,
+		var member map[string]interface{}
+		if config, ok := declaredKeys[teamMemberKey(byUsername)]; ok && m.User.Username != "" {
+			member = map[string]interface{}{"id": "", "username": config["username"]}
+		} else if _, ok := declaredKeys[teamMemberKey(byId)]; ok || !onlyDeclared {
+			member = map[string]interface{}{"id": m.User.ID, "username": ""}
+		} else {
+			continue
 		}
-
+		member["role"] = m.Role
 		members = append(members, member)
 	}
 
 	return members
 }
 
+// applyOpsGenieTeamMemberChanges adds and removes members one by one so that
+// members which are not part of either list are left alone. A changed role is
+// applied by adding the member again after removing it.
+func applyOpsGenieTeamMemberChanges(ctx context.Context, client *team.Client, teamId string, oldMembers, newMembers []interface{}) error {
+	oldByKey := make(map[string]map[string]interface{}, len(oldMembers))
+	for _, v := range oldMembers {
+		config := v.(map[string]interface{})
+		oldByKey[teamMemberKey(config)] = config
+	}
+	newByKey := make(map[string]map[string]interface{}, len(newMembers))
+	for _, v := range newMembers {
+		config := v.(map[string]interface{})
+		newByKey[teamMemberKey(config)] = config
+	}
+
+	for key, config := range oldByKey {
+		if newConfig, ok := newByKey[key]; ok && newConfig["role"] == config["role"] {
+			continue
+		}
+		request := &team.RemoveTeamMemberRequest{
+			TeamIdentifierType:    team.Id,
+			TeamIdentifierValue:   teamId,
+			MemberIdentifierType:  team.Id,
+			MemberIdentifierValue: config["id"].(string),
+		}
+		if username := config["username"].(string); username != "" {
+			request.MemberIdentifierType = team.Username
+			request.MemberIdentifierValue = username
+		}
+		log.Printf("[INFO] Removing member '%s' from OpsGenie team '%s'", request.MemberIdentifierValue, teamId)
+		if _, err := client.RemoveMember(ctx, request); err != nil {
+			return err
+		}
+	}
+
+	for key, config := range newByKey {
+		if oldConfig, ok := oldByKey[key]; ok && oldConfig["role"] == config["role"] {
+			continue
+		}
+		member, err := expandOpsGenieTeamMember(config)
+		if err != nil {
+			return err
+		}
+		log.Printf("[INFO] Adding member '%s%s' to OpsGenie team '%s'", member.User.ID, member.User.Username, teamId)
+		_, err = client.AddMember(ctx, &team.AddTeamMemberRequest{
+			TeamIdentifierType:  team.Id,
+			TeamIdentifierValue: teamId,
+			User:                member.User,
+			Role:                member.Role,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func validateOpsGenieTeamName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^[a-zA-Z 0-9_.-]+$`).MatchString(value) {
@@ -250,82 +473,207 @@ func validateOpsGenieTeamName(v interface{}, k string) (ws []string, errors []er
 	return
 }
 
-func findAndDeleteDefaultSchedule(teamName string, config *client.Config) error {
-	scheduleClient, err := schedule.NewClient(config)
-	if err != nil {
-		return err
+func opsGenieTeamDefaultResourcesMode(d *schema.ResourceData) string {
+	return teamDefaultResourcesModeOf(d.Get("delete_default_resources").(bool), d.Get("default_resources_mode").(string))
+}
+
+func teamDefaultResourcesModeOf(deleteDefaultResources bool, mode string) string {
+	if deleteDefaultResources {
+		return teamDefaultResourcesDelete
+	}
+	if mode != "" {
+		return mode
+	}
+	return teamDefaultResourcesKeep
+}
+
+// customizeOpsGenieTeamDiff rejects switching an existing team to the delete
+// default resources mode, which is only applied when the team is created.
+// Switching to adopt is fine, the default resources are looked up on refresh.
+func customizeOpsGenieTeamDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !(d.HasChange("default_resources_mode") || d.HasChange("delete_default_resources")) {
+		return nil
+	}
+	oldDelete, newDelete := d.GetChange("delete_default_resources")
+	oldMode, newMode := d.GetChange("default_resources_mode")
+	if teamDefaultResourcesModeOf(newDelete.(bool), newMode.(string)) == teamDefaultResourcesDelete &&
+		teamDefaultResourcesModeOf(oldDelete.(bool), oldMode.(string)) != teamDefaultResourcesDelete {
+		return fmt.Errorf("default_resources_mode can only be set to %q when the team is created, the default resources of an existing team may already be in use. Delete them in OpsGenie, or set default_resources_mode to %q to import and manage them", teamDefaultResourcesDelete, teamDefaultResourcesAdopt)
+	}
+	return nil
+}
+
+type opsGenieTeamDefaults struct {
+	routingRuleId string
+	escalationId  string
+	scheduleId    string
+}
+
+func (defaults *opsGenieTeamDefaults) set(d *schema.ResourceData) {
+	d.Set("default_routing_rule_id", defaults.routingRuleId)
+	d.Set("default_escalation_id", defaults.escalationId)
+	d.Set("default_schedule_id", defaults.scheduleId)
+}
+
+// readOpsGenieTeamDefaults tracks the default resources of adopted teams. They
+// are looked up once, as the default routing rule may be pointed elsewhere
+// later on while the escalation and schedule keep existing. When they can no
+// longer be resolved the ids are left empty and a warning is returned.
+func readOpsGenieTeamDefaults(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if opsGenieTeamDefaultResourcesMode(d) != teamDefaultResourcesAdopt {
+		(&opsGenieTeamDefaults{}).set(d)
+		return nil
+	}
+	if d.Get("default_routing_rule_id").(string) != "" {
+		return nil
+	}
+
+	defaults, err := findOpsGenieTeamDefaults(ctx, d.Id(), meta.(*OpsgenieClient).client.Config)
+	if _, ok := asApiError(err); ok {
+		return diag.Diagnostics{attributeDiagnostic("default_resources_mode", "Failed to find default resources of team", err)}
 	}
-	expand := true
-	res, err := scheduleClient.List(context.Background(), &schedule.ListRequest{
-		Expand: &expand,
-	})
 	if err != nil {
-		return err
+		(&opsGenieTeamDefaults{}).set(d)
+		warning := attributeDiagnostic("default_resources_mode", "Could not resolve default resources of team", err)
+		warning.Severity = diag.Warning
+		return diag.Diagnostics{warning}
 	}
-	for _, sched := range res.Schedule {
-		ownerTeam := sched.OwnerTeam
-		if ownerTeam != nil {
-			if ownerTeam.Name == teamName {
-				_, err = scheduleClient.Delete(context.Background(), &schedule.DeleteRequest{
-					IdentifierType:  schedule.Id,
-					IdentifierValue: sched.Id,
-				})
-				if err != nil {
-					return err
-				}
-				return nil
+	defaults.set(d)
+	return nil
+}
+
+func waitForOpsGenieTeamDefaults(ctx context.Context, teamId string, config *client.Config) (*opsGenieTeamDefaults, error) {
+	var defaults *opsGenieTeamDefaults
+	err := resource.RetryContext(ctx, teamDefaultsSettleTimeout, func() *resource.RetryError {
+		var err error
+		defaults, err = findOpsGenieTeamDefaults(ctx, teamId, config)
+		if err != nil {
+			if _, ok := asApiError(err); ok {
+				return resource.NonRetryableError(err)
 			}
+			return resource.RetryableError(err)
 		}
+		return nil
+	})
+	return defaults, err
+}
+
+// findOpsGenieTeamDefaults follows the default routing rule of the team to the
+// escalation it notifies, and that escalation to the schedule it notifies.
+// Only resources owned by the team itself are reported.
+func findOpsGenieTeamDefaults(ctx context.Context, teamId string, config *client.Config) (*opsGenieTeamDefaults, error) {
+	teamClient, err := team.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := teamClient.ListRoutingRules(ctx, &team.ListRoutingRulesRequest{
+		TeamIdentifierType:  team.Id,
+		TeamIdentifierValue: teamId,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return errors.New("Could not find any schedule name for this team")
-}
+	defaults := &opsGenieTeamDefaults{}
+	var notify team.Notify
+	for _, rule := range rules.RoutingRules {
+		if rule.IsDefault {
+			defaults.routingRuleId = rule.Id
+			notify = rule.Notify
+			break
+		}
+	}
+	if defaults.routingRuleId == "" {
+		return nil, fmt.Errorf("could not find the default routing rule of team %s", teamId)
+	}
+	if notify.Type != team.EscalationNotifyType || notify.Id == "" {
+		return nil, fmt.Errorf("the default routing rule of team %s does not notify an escalation", teamId)
+	}
 
-func findAndDeleteDefaultEscalation(teamName string, config *client.Config) error {
 	escalationClient, err := escalation.NewClient(config)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	res, err := escalationClient.List(context.Background())
+	escal, err := escalationClient.Get(ctx, &escalation.GetRequest{
+		IdentifierType: escalation.Id,
+		Identifier:     notify.Id,
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	for _, escal := range res.Escalations {
-		ownerTeam := escal.OwnerTeam
-		if ownerTeam != nil {
-			if ownerTeam.Name == teamName {
-				_, err = escalationClient.Delete(context.Background(), &escalation.DeleteRequest{
-					IdentifierType: escalation.Id,
-					Identifier:     escal.Id,
-				})
-				if err != nil {
-					return err
-				}
-				return nil
-			}
+	if escal.OwnerTeam == nil || escal.OwnerTeam.Id != teamId {
+		return nil, fmt.Errorf("escalation %s notified by the default routing rule is not owned by team %s", escal.Id, teamId)
+	}
+	defaults.escalationId = escal.Id
+
+	scheduleClient, err := schedule.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range escal.Rules {
+		if rule.Recipient.Type != og.Schedule {
+			continue
+		}
+		sched, err := scheduleClient.Get(ctx, &schedule.GetRequest{
+			IdentifierType:  schedule.Id,
+			IdentifierValue: rule.Recipient.Id,
+		})
+		if err != nil {
+			return nil, err
 		}
+		if sched.Schedule.OwnerTeam != nil && sched.Schedule.OwnerTeam.Id == teamId {
+			defaults.scheduleId = sched.Schedule.Id
+			break
+		}
+	}
+	if defaults.scheduleId == "" {
+		return nil, fmt.Errorf("could not find the default schedule of team %s", teamId)
+	}
+
+	return defaults, nil
+}
+
+func deleteDefaultSchedule(ctx context.Context, scheduleId string, config *client.Config) error {
+	scheduleClient, err := schedule.NewClient(config)
+	if err != nil {
+		return err
 	}
+	_, err = scheduleClient.Delete(ctx, &schedule.DeleteRequest{
+		IdentifierType:  schedule.Id,
+		IdentifierValue: scheduleId,
+	})
+	return err
+}
 
-	return errors.New("Could not find any escalation for this team")
+func deleteDefaultEscalation(ctx context.Context, escalationId string, config *client.Config) error {
+	escalationClient, err := escalation.NewClient(config)
+	if err != nil {
+		return err
+	}
+	_, err = escalationClient.Delete(ctx, &escalation.DeleteRequest{
+		IdentifierType: escalation.Id,
+		Identifier:     escalationId,
+	})
+	return err
 }
 
-func findAndUpdateDefaultRoutingRule(teamName string, config *client.Config) error {
+func updateDefaultRoutingRules(ctx context.Context, teamId string, config *client.Config) error {
 	teamClient, err := team.NewClient(config)
 	if err != nil {
 		return err
 	}
-	rules, err := teamClient.ListRoutingRules(context.Background(), &team.ListRoutingRulesRequest{
-		TeamIdentifierType:  team.Name,
-		TeamIdentifierValue: teamName,
+	rules, err := teamClient.ListRoutingRules(ctx, &team.ListRoutingRulesRequest{
+		TeamIdentifierType:  team.Id,
+		TeamIdentifierValue: teamId,
 	})
 	if err != nil {
 		return err
 	}
 
 	for _, rule := range rules.RoutingRules {
-		_, err := teamClient.UpdateRoutingRule(context.Background(), &team.UpdateRoutingRuleRequest{
-			TeamIdentifierType:  team.Name,
-			TeamIdentifierValue: teamName,
+		_, err := teamClient.UpdateRoutingRule(ctx, &team.UpdateRoutingRuleRequest{
+			TeamIdentifierType:  team.Id,
+			TeamIdentifierValue: teamId,
 			RoutingRuleId:       rule.Id,
 			Notify: &team.Notify{
 				Type: team.None,
@@ -0,0 +1,86 @@
+package opsgenie
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceOpsGenieTeamV0 is the schema of opsgenie_team before members became
+// a set, kept only to decode old state.
+func resourceOpsGenieTeamV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ignore_members": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"delete_default_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"member": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "user",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceOpsGenieTeamStateUpgradeV0 moves the member list into the member set,
+// dropping duplicate users. membership_mode gets its default, ignore_members is
+// kept and still takes precedence through opsGenieTeamMembershipMode, so
+// configurations which keep using ignore_members do not show a diff.
+func resourceOpsGenieTeamStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	rawState["membership_mode"] = teamMembershipModeAuthoritative
+
+	oldMembers, _ := rawState["member"].([]interface{})
+	members := make([]interface{}, 0, len(oldMembers))
+	seen := make(map[string]bool, len(oldMembers))
+	for _, v := range oldMembers {
+		old, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		member := map[string]interface{}{
+			"id":       old["id"],
+			"username": "",
+			"role":     old["role"],
+		}
+		key := teamMemberKey(member)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		members = append(members, member)
+	}
+	rawState["member"] = members
+
+	return rawState, nil
+}
@@ -0,0 +1,57 @@
+package opsgenie
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestResourceOpsGenieTeamStateUpgradeV0(t *testing.T) {
+	cases := map[string]struct {
+		state    map[string]interface{}
+		expected map[string]interface{}
+	}{
+		"members": {
+			state: map[string]interface{}{
+				"name": "team",
+				"member": []interface{}{
+					map[string]interface{}{"id": "b", "role": "admin"},
+					map[string]interface{}{"id": "a", "role": "user"},
+					map[string]interface{}{"id": "B", "role": "user"},
+				},
+			},
+			expected: map[string]interface{}{
+				"name":            "team",
+				"membership_mode": teamMembershipModeAuthoritative,
+				"member": []interface{}{
+					map[string]interface{}{"id": "b", "username": "", "role": "admin"},
+					map[string]interface{}{"id": "a", "username": "", "role": "user"},
+				},
+			},
+		},
+		"ignore members": {
+			state: map[string]interface{}{
+				"name":           "team",
+				"ignore_members": true,
+			},
+			expected: map[string]interface{}{
+				"name":            "team",
+				"ignore_members":  true,
+				"membership_mode": teamMembershipModeAuthoritative,
+				"member":          []interface{}{},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := resourceOpsGenieTeamStateUpgradeV0(context.Background(), c.state, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(actual, c.expected) {
+				t.Errorf("expected %#v, got %#v", c.expected, actual)
+			}
+		})
+	}
+}
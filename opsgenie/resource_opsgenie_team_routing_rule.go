@@ -6,21 +6,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/opsgenie/opsgenie-go-sdk-v2/og"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/opsgenie/opsgenie-go-sdk-v2/team"
 )
 
 func resourceOpsGenieTeamRoutingRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsGenieTeamRoutingRuleCreate,
-		Read:   handleNonExistentResource(resourceOpsGenieTeamRoutingRuleRead),
-		Update: resourceOpsGenieTeamRoutingRuleUpdate,
-		Delete: resourceOpsGenieTeamRoutingRuleDelete,
+		CreateContext: resourceOpsGenieTeamRoutingRuleCreate,
+		ReadContext:   resourceOpsGenieTeamRoutingRuleRead,
+		UpdateContext: resourceOpsGenieTeamRoutingRuleUpdate,
+		DeleteContext: resourceOpsGenieTeamRoutingRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), "/")
 				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 					return nil, fmt.Errorf("Unexpected format of ID (%q), expected team_id/routing_rule_id", d.Id())
@@ -32,6 +34,12 @@ func resourceOpsGenieTeamRoutingRule() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -195,10 +203,10 @@ func resourceOpsGenieTeamRoutingRule() *schema.Resource {
 	}
 }
 
-func resourceOpsGenieTeamRoutingRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieTeamRoutingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := team.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 	teamId := d.Get("team_id").(string)
@@ -210,7 +218,7 @@ func resourceOpsGenieTeamRoutingRuleCreate(d *schema.ResourceData, meta interfac
 
 	expandedCriteria := expandOpsgenieCriteria(criteria)
 	if err := validateOpsgenieCriteria(expandedCriteria); err != nil {
-		return err
+		return diag.Diagnostics{attributeDiagnostic("criteria", "Invalid routing rule criteria", err)}
 	}
 
 	createRequest := &team.CreateRoutingRuleRequest{
@@ -229,19 +237,19 @@ func resourceOpsGenieTeamRoutingRuleCreate(d *schema.ResourceData, meta interfac
 
 	log.Printf("[INFO] Creating OpsGenie team routing rule '%s'", name)
 
-	result, err := client.CreateRoutingRule(context.Background(), createRequest)
+	result, err := client.CreateRoutingRule(ctx, createRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	d.SetId(result.Id)
 
-	return resourceOpsGenieTeamRoutingRuleRead(d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsGenieTeamRoutingRuleRead)
 }
 
-func resourceOpsGenieTeamRoutingRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieTeamRoutingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := team.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	getRequest := &team.GetRoutingRuleRequest{
@@ -250,9 +258,9 @@ func resourceOpsGenieTeamRoutingRuleRead(d *schema.ResourceData, meta interface{
 		RoutingRuleId:       d.Id(),
 	}
 
-	result, err := client.GetRoutingRule(context.Background(), getRequest)
+	result, err := client.GetRoutingRule(ctx, getRequest)
 	if err != nil {
-		return err
+		return handleNonExistentResource(d, err)
 	}
 	d.Set("is_default", result.IsDefault)
 	d.Set("name", result.Name)
@@ -265,10 +273,10 @@ func resourceOpsGenieTeamRoutingRuleRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceOpsGenieTeamRoutingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieTeamRoutingRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := team.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	name := d.Get("name").(string)
 	order := d.Get("order").(int)
@@ -281,7 +289,7 @@ func resourceOpsGenieTeamRoutingRuleUpdate(d *schema.ResourceData, meta interfac
 
 	expandedCriteria := expandOpsgenieCriteria(criteria)
 	if err := validateOpsgenieCriteria(expandedCriteria); err != nil {
-		return err
+		return diag.Diagnostics{attributeDiagnostic("criteria", "Invalid routing rule criteria", err)}
 	}
 
 	updateRequest := &team.UpdateRoutingRuleRequest{
@@ -301,29 +309,29 @@ func resourceOpsGenieTeamRoutingRuleUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	log.Printf("[INFO] Updating OpsGenie team routing rule '%s'", name)
-	_, err = client.UpdateRoutingRule(context.Background(), updateRequest)
+	_, err = client.UpdateRoutingRule(ctx, updateRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
-	_, err = client.ChangeRoutingRuleOrder(context.Background(), &team.ChangeRoutingRuleOrderRequest{
+	_, err = client.ChangeRoutingRuleOrder(ctx, &team.ChangeRoutingRuleOrderRequest{
 		RoutingRuleId:       d.Id(),
 		TeamIdentifierType:  team.Id,
 		TeamIdentifierValue: teamId,
 		Order:               &order,
 	})
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
 }
 
-func resourceOpsGenieTeamRoutingRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieTeamRoutingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting OpsGenie team routing rule'%s'", d.Get("name").(string))
 	client, err := team.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	deleteRequest := &team.DeleteRoutingRuleRequest{
 		TeamIdentifierType:  team.Id,
@@ -331,9 +339,9 @@ func resourceOpsGenieTeamRoutingRuleDelete(d *schema.ResourceData, meta interfac
 		RoutingRuleId:       d.Id(),
 	}
 
-	_, err = client.DeleteRoutingRule(context.Background(), deleteRequest)
+	_, err = client.DeleteRoutingRule(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
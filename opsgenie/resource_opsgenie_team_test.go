@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -195,6 +197,118 @@ func TestAccOpsGenieTeam_complete(t *testing.T) {
 	})
 }
 
+func TestAccOpsGenieTeam_additiveMembers(t *testing.T) {
+	randomTeam := acctest.RandString(6)
+	randomUser := acctest.RandString(6)
+	config := testAccOpsGenieTeam_additiveMembers(randomUser, randomTeam)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testCheckOpsGenieTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOpsGenieTeamExists("opsgenie_team.test"),
+					resource.TestCheckResourceAttr("opsgenie_team.test", "member.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("opsgenie_team.test", "member.*", map[string]string{
+						"role": "admin",
+					}),
+					addTeamMember("opsgenie_team.test", "opsgenie_user.other"),
+				),
+			},
+			{
+				// The member added outside of Terraform is left alone
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("opsgenie_team.test", "member.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpsGenieTeam_adoptDefaultResources(t *testing.T) {
+	randomTeam := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testCheckOpsGenieTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsGenieTeam_defaultResourcesMode(randomTeam, "adopt"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOpsGenieTeamExists("opsgenie_team.test"),
+					resource.TestCheckResourceAttrSet("opsgenie_team.test", "default_routing_rule_id"),
+					resource.TestCheckResourceAttrSet("opsgenie_team.test", "default_escalation_id"),
+					resource.TestCheckResourceAttrSet("opsgenie_team.test", "default_schedule_id"),
+				),
+			},
+			{
+				ResourceName:            "opsgenie_team.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"default_resources_mode", "default_routing_rule_id", "default_escalation_id", "default_schedule_id", "membership_mode"},
+			},
+			{
+				Config:      testAccOpsGenieTeam_defaultResourcesMode(randomTeam, "delete"),
+				ExpectError: regexp.MustCompile(`default_resources_mode can only be set to "delete" when the team is created`),
+			},
+		},
+	})
+}
+
+func TestFlattenOpsGenieTeamResourceMembers(t *testing.T) {
+	input := []team.Member{
+		{User: team.User{ID: "1", Username: "first@example.com"}, Role: "admin"},
+		{User: team.User{ID: "2", Username: "second@example.com"}, Role: "user"},
+		{User: team.User{ID: "3", Username: "third@example.com"}, Role: "user"},
+	}
+	declared := []interface{}{
+		map[string]interface{}{"id": "", "username": "First@example.com", "role": "admin"},
+		map[string]interface{}{"id": "2", "username": "", "role": "admin"},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"id": "", "username": "First@example.com", "role": "admin"},
+		map[string]interface{}{"id": "2", "username": "", "role": "user"},
+		map[string]interface{}{"id": "3", "username": "", "role": "user"},
+	}
+	if actual := flattenOpsGenieTeamResourceMembers(input, declared, false); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %#v, got %#v", expected, actual)
+	}
+	if actual := flattenOpsGenieTeamResourceMembers(input, declared, true); !reflect.DeepEqual(actual, expected[:2]) {
+		t.Errorf("expected only declared members %#v, got %#v", expected[:2], actual)
+	}
+}
+
+func addTeamMember(teamName string, userName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		teamResource, ok := s.RootModule().Resources[teamName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", teamName)
+		}
+
+		userResource, ok := s.RootModule().Resources[userName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", userName)
+		}
+
+		client, err := team.NewClient(testAccProvider.Meta().(*OpsgenieClient).client.Config)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.AddMember(context.Background(), &team.AddTeamMemberRequest{
+			TeamIdentifierType:  team.Id,
+			TeamIdentifierValue: teamResource.Primary.ID,
+			User:                team.User{ID: userResource.Primary.ID},
+			Role:                "user",
+		})
+		return err
+	}
+}
+
 func testCheckOpsGenieTeamDestroy(s *terraform.State) error {
 	client, err := team.NewClient(testAccProvider.Meta().(*OpsgenieClient).client.Config)
 	if err != nil {
@@ -292,3 +406,39 @@ resource "opsgenie_team" "test" {
 }
 `, randomUser, randomTeam)
 }
+
+func testAccOpsGenieTeam_additiveMembers(randomUser, randomTeam string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_user" "test" {
+  username  = "genietest-%[1]s@opsgenie.com"
+  full_name = "Acceptance Test User"
+  role      = "User"
+}
+resource "opsgenie_user" "other" {
+  username  = "genietest-other-%[1]s@opsgenie.com"
+  full_name = "Acceptance Test User"
+  role      = "User"
+}
+resource "opsgenie_team" "test" {
+  name            = "genieteam-%[2]s"
+  description     = "This team deals with all the things"
+  membership_mode = "additive"
+  depends_on      = [opsgenie_user.other] # The member added by the test has to be removed before its user
+
+  member {
+    username = opsgenie_user.test.username
+    role     = "admin"
+  }
+}
+`, randomUser, randomTeam)
+}
+
+func testAccOpsGenieTeam_defaultResourcesMode(randomTeam, mode string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_team" "test" {
+  name                   = "genieteam-%s"
+  description            = "This team deals with all the things"
+  default_resources_mode = %q
+}
+`, randomTeam, mode)
+}
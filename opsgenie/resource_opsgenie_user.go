@@ -4,23 +4,32 @@ import (
 	"context"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/opsgenie/opsgenie-go-sdk-v2/user"
 
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceOpsGenieUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsGenieUserCreate,
-		Read:   handleNonExistentResource(resourceOpsGenieUserRead),
-		Update: resourceOpsGenieUserUpdate,
-		Delete: resourceOpsGenieUserDelete,
+		CreateContext: resourceOpsGenieUserCreate,
+		ReadContext:   resourceOpsGenieUserRead,
+		UpdateContext: resourceOpsGenieUserUpdate,
+		DeleteContext: resourceOpsGenieUserDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"username": {
@@ -97,6 +106,10 @@ func resourceOpsGenieUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"force_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 		},
 	}
 }
@@ -165,11 +178,11 @@ func expandOpsGenieUserDetails(d *schema.ResourceData) map[string][]string {
 	return output
 }
 
-func resourceOpsGenieUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := user.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	username := d.Get("username").(string)
 	fullName := d.Get("full_name").(string)
@@ -202,30 +215,30 @@ func resourceOpsGenieUserCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[INFO] Creating OpsGenie user '%s'", username)
-	result, err := client.Create(context.Background(), createRequest)
+	result, err := client.Create(ctx, createRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	d.SetId(result.Id)
 
-	return resourceOpsGenieUserRead(d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsGenieUserRead)
 }
 
-func resourceOpsGenieUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := user.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	username := d.Get("username").(string)
 
 	log.Printf("[INFO] Reading OpsGenie user '%s'", username)
 
-	usr, err := client.Get(context.Background(), &user.GetRequest{
+	usr, err := client.Get(ctx, &user.GetRequest{
 		Identifier: d.Id(),
 	})
 	if err != nil {
-		return err
+		return handleNonExistentResource(d, err)
 	}
 	d.Set("username", usr.Username)
 	d.Set("full_name", usr.FullName)
@@ -242,10 +255,10 @@ func resourceOpsGenieUserRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceOpsGenieUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := user.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	username := d.Get("username").(string)
 	fullName := d.Get("full_name").(string)
@@ -279,32 +292,130 @@ func resourceOpsGenieUserUpdate(d *schema.ResourceData, meta interface{}) error
 		SkypeUsername: skypeUsername,
 	}
 
-	_, err = client.Update(context.Background(), updateRequest)
+	_, err = client.Update(ctx, updateRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
 }
 
-func resourceOpsGenieUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting OpsGenie user '%s'", d.Get("username").(string))
 	client, err := user.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
+
+	if !d.Get("force_delete").(bool) {
+		if diags := checkOpsGenieUserNotReferenced(ctx, d, client); diags.HasError() {
+			return diags
+		}
+	}
+
 	deleteRequest := &user.DeleteRequest{
 		Identifier: d.Id(),
 	}
 
-	_, err = client.Delete(context.Background(), deleteRequest)
+	_, err = client.Delete(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 
 	return nil
 }
 
+// userReferencesSettleTimeout bounds how long a delete waits for references
+// to the user to go away, when all of them are being removed in the same
+// apply. Resources that refer to the user are changed or destroyed before it,
+// but OpsGenie can take a moment to stop reporting them.
+const userReferencesSettleTimeout = time.Minute
+
+// userReferencesRecheckTimeout bounds how long a delete re-checks references
+// which are not being removed in the same apply, before it fails.
+const userReferencesRecheckTimeout = 5 * time.Second
+
+// changedUserReferences holds the IDs of the teams, escalations and schedules
+// changed or deleted by this provider, so that deleting a user only waits for
+// references which are being removed in the same apply.
+var changedUserReferences = struct {
+	sync.Mutex
+	ids map[string]bool
+}{ids: map[string]bool{}}
+
+// recordOpsGenieUserReferencesChanged marks a team, escalation or schedule as
+// changed, as any of its references to users may have been removed.
+func recordOpsGenieUserReferencesChanged(id string) {
+	changedUserReferences.Lock()
+	defer changedUserReferences.Unlock()
+	changedUserReferences.ids[id] = true
+}
+
+// opsGenieUserReferencesChanging reports whether all refs are held by
+// teams, escalations or schedules which were changed in this apply.
+func opsGenieUserReferencesChanging(refs *opsGenieUserReferences) bool {
+	changedUserReferences.Lock()
+	defer changedUserReferences.Unlock()
+
+	if len(refs.forwardingRules) > 0 {
+		return false
+	}
+	ids := make([]string, 0)
+	for _, t := range refs.teams {
+		ids = append(ids, t.Id)
+	}
+	for _, e := range refs.escalations {
+		ids = append(ids, e.Id)
+	}
+	for _, s := range refs.schedules {
+		ids = append(ids, s.Id)
+	}
+	for _, id := range ids {
+		if !changedUserReferences.ids[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkOpsGenieUserNotReferenced fails when teams, escalations, schedules or
+// forwarding rules still refer to the user, since deleting it would silently
+// remove it from all of them.
+func checkOpsGenieUserNotReferenced(ctx context.Context, d *schema.ResourceData, client *user.Client) diag.Diagnostics {
+	var refs *opsGenieUserReferences
+	start := time.Now()
+	err := resource.RetryContext(ctx, userReferencesSettleTimeout, func() *resource.RetryError {
+		var err error
+		refs, err = getOpsGenieUserReferences(ctx, client, d.Id())
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if len(refs.describe()) == 0 {
+			return nil
+		}
+		err = fmt.Errorf("user %s is still referenced", d.Id())
+		if !opsGenieUserReferencesChanging(refs) && time.Since(start) >= userReferencesRecheckTimeout {
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(err)
+	})
+	if err == nil {
+		return nil
+	}
+	if refs == nil || len(refs.describe()) == 0 {
+		return apiErrorDiagnostics(err)
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("User %s is still referenced in OpsGenie", d.Get("username").(string)),
+			Detail: fmt.Sprintf("Deleting the user would remove it from:\n%s\n\nRemove these references first, or set force_delete = true to delete the user anyway.",
+				refs),
+		},
+	}
+}
+
 func validateOpsGenieUserUsername(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
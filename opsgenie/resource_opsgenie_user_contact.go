@@ -4,20 +4,22 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/opsgenie/opsgenie-go-sdk-v2/contact"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceOpsGenieUserContact() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceOpsGenieUserContactCreate,
-		Read:   handleNonExistentResource(resourceOpsGenieUserContactRead),
-		Update: resourceOpsGenieUserContactUpdate,
-		Delete: resourceOpsGenieUserContactDelete,
+		CreateContext: resourceOpsGenieUserContactCreate,
+		ReadContext:   resourceOpsGenieUserContactRead,
+		UpdateContext: resourceOpsGenieUserContactUpdate,
+		DeleteContext: resourceOpsGenieUserContactDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), "/")
 				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 					return nil, fmt.Errorf("Unexpected format of ID (%q), expected username/contact_id", d.Id())
@@ -29,6 +31,12 @@ func resourceOpsGenieUserContact() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"username": {
 				Type:     schema.TypeString,
@@ -54,11 +62,11 @@ func resourceOpsGenieUserContact() *schema.Resource {
 	}
 }
 
-func resourceOpsGenieUserContactCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieUserContactCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	client, err := contact.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	userId := d.Get("username").(string)
 	method := d.Get("method").(string)
@@ -71,46 +79,46 @@ func resourceOpsGenieUserContactCreate(d *schema.ResourceData, meta interface{})
 		MethodOfContact: contact.MethodType(method),
 	}
 
-	result, err := client.Create(context.Background(), createRequest)
+	result, err := client.Create(ctx, createRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	d.SetId(result.Id)
 
 	if enabled {
-		_, err = client.Enable(context.Background(), &contact.EnableRequest{
+		_, err = client.Enable(ctx, &contact.EnableRequest{
 			UserIdentifier:    userId,
 			ContactIdentifier: result.Id,
 		})
 		if err != nil {
-			return err
+			return apiErrorDiagnostics(err)
 		}
 	} else {
-		_, err = client.Disable(context.Background(), &contact.DisableRequest{
+		_, err = client.Disable(ctx, &contact.DisableRequest{
 			UserIdentifier:    userId,
 			ContactIdentifier: result.Id,
 		})
 		if err != nil {
-			return err
+			return apiErrorDiagnostics(err)
 		}
 	}
 
-	return resourceOpsGenieUserContactRead(d, meta)
+	return readAfterCreate(ctx, d, meta, resourceOpsGenieUserContactRead)
 }
 
-func resourceOpsGenieUserContactRead(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieUserContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := contact.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	userId := d.Get("username").(string)
 
-	contactsResult, err := client.Get(context.Background(), &contact.GetRequest{
+	contactsResult, err := client.Get(ctx, &contact.GetRequest{
 		UserIdentifier:    userId,
 		ContactIdentifier: d.Id(),
 	})
 	if err != nil {
-		return err
+		return handleNonExistentResource(d, err)
 	}
 
 	d.Set("method", contactsResult.MethodOfContact)
@@ -120,10 +128,10 @@ func resourceOpsGenieUserContactRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceOpsGenieUserContactUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieUserContactUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := contact.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	userId := d.Get("username").(string)
 	enabled := d.Get("enabled").(bool)
@@ -134,34 +142,34 @@ func resourceOpsGenieUserContactUpdate(d *schema.ResourceData, meta interface{})
 		ContactIdentifier: d.Id(),
 		To:                to,
 	}
-	_, err = client.Update(context.Background(), updateRequest)
+	_, err = client.Update(ctx, updateRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	if enabled {
-		_, err = client.Enable(context.Background(), &contact.EnableRequest{
+		_, err = client.Enable(ctx, &contact.EnableRequest{
 			UserIdentifier:    userId,
 			ContactIdentifier: d.Id(),
 		})
 		if err != nil {
-			return err
+			return apiErrorDiagnostics(err)
 		}
 	} else {
-		_, err = client.Disable(context.Background(), &contact.DisableRequest{
+		_, err = client.Disable(ctx, &contact.DisableRequest{
 			UserIdentifier:    userId,
 			ContactIdentifier: d.Id(),
 		})
 		if err != nil {
-			return err
+			return apiErrorDiagnostics(err)
 		}
 	}
 	return nil
 }
 
-func resourceOpsGenieUserContactDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceOpsGenieUserContactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := contact.NewClient(meta.(*OpsgenieClient).client.Config)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	userId := d.Get("username").(string)
 
@@ -170,9 +178,9 @@ func resourceOpsGenieUserContactDelete(d *schema.ResourceData, meta interface{})
 		ContactIdentifier: d.Id(),
 	}
 
-	dr, err := client.Delete(context.Background(), deleteRequest)
+	dr, err := client.Delete(ctx, deleteRequest)
 	if err != nil {
-		return err
+		return apiErrorDiagnostics(err)
 	}
 	_ = dr
 
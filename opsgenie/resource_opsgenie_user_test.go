@@ -106,6 +106,49 @@ func TestAccOpsGenieUser_complete(t *testing.T) {
 	})
 }
 
+func TestAccOpsGenieUser_referencedDelete(t *testing.T) {
+	rs := acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testCheckOpsGenieUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsGenieUser_referenced(rs),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOpsGenieUserExists("opsgenie_user.test"),
+				),
+			},
+			{
+				Config:      testAccOpsGenieUser_referencedTeamOnly(rs),
+				ExpectError: regexp.MustCompile("is still referenced in OpsGenie"),
+			},
+		},
+	})
+}
+
+func TestOpsGenieUserReferencesChanging(t *testing.T) {
+	refs := &opsGenieUserReferences{
+		teams:     []user.Team{{Id: "changed-team"}},
+		schedules: []user.Schedule{{Id: "unchanged-schedule"}},
+	}
+	recordOpsGenieUserReferencesChanged("changed-team")
+
+	if opsGenieUserReferencesChanging(refs) {
+		t.Error("expected a reference from an unchanged schedule not to be waited for")
+	}
+
+	refs.schedules = nil
+	if !opsGenieUserReferencesChanging(refs) {
+		t.Error("expected references from changed teams to be waited for")
+	}
+
+	refs.forwardingRules = []user.ForwardingRule{{Id: "rule"}}
+	if opsGenieUserReferencesChanging(refs) {
+		t.Error("expected forwarding rules not to be waited for")
+	}
+}
+
 func TestAccOpsGenieUser_usernameValidationError(t *testing.T) {
 	rs := acctest.RandString(6)
 	config := testAccOpsGenieUser_usernameValidationError(rs)
@@ -210,6 +253,39 @@ resource "opsgenie_user" "test" {
 `, rString)
 }
 
+func testAccOpsGenieUser_referenced(rString string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_user" "test" {
+  username  = "genietest-%[1]s@opsgenie.com"
+  full_name = "Acceptance Test User"
+  role      = "User"
+}
+resource "opsgenie_team" "test" {
+  name        = "genieteam-%[1]s"
+  description = "This team deals with all the things"
+  member {
+    id   = opsgenie_user.test.id
+    role = "user"
+  }
+  lifecycle {
+    ignore_changes = [member]
+  }
+}
+`, rString)
+}
+
+func testAccOpsGenieUser_referencedTeamOnly(rString string) string {
+	return fmt.Sprintf(`
+resource "opsgenie_team" "test" {
+  name        = "genieteam-%[1]s"
+  description = "This team deals with all the things"
+  lifecycle {
+    ignore_changes = [member]
+  }
+}
+`, rString)
+}
+
 func testAccOpsGenieUser_usernameValidationError(rString string) string {
 	return fmt.Sprintf(`
 resource "opsgenie_user" "test" {
@@ -1,31 +1,96 @@
 package opsgenie
 
 import (
+	"context"
 	"fmt"
-	"net/http"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// handleNonExistentResource is a wrapper of resourceFunc that
-// handles errors returned by a read function.
-func handleNonExistentResource(f schema.ReadFunc) schema.ReadFunc {
-	return func(d *schema.ResourceData, meta interface{}) error {
-		if err := f(d, meta); err != nil {
-			// if the error that we receive is an ApiError and
-			// the status code is 404, it means we need to re-create
-			// the specific resource
-			apiErr, ok := err.(*client.ApiError)
-			if !ok || apiErr.StatusCode != http.StatusNotFound {
-				return err
-			}
-			d.SetId("")
+// handleNonExistentResource handles errors returned while reading a resource.
+// If the error is a 404 from the OpsGenie API, the resource no longer exists
+// and its id is cleared so that Terraform plans to re-create it.
+func handleNonExistentResource(d *schema.ResourceData, err error) diag.Diagnostics {
+	if !isNotFoundError(err) {
+		return apiErrorDiagnostics(err)
+	}
+	log.Printf("[WARN] Removing %s from state because it no longer exists in OpsGenie", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// createReadRetryTimeout bounds how long readAfterCreate keeps retrying reads
+// of a freshly created resource that OpsGenie still reports as not found.
+const createReadRetryTimeout = 2 * time.Minute
+
+// readAfterCreate reads a resource right after it has been created. OpsGenie is
+// eventually consistent, so a freshly created object may be reported as not
+// found for a short while. Such reads are retried until createReadRetryTimeout
+// elapses or ctx is done. The id must already be set on d, so that the resource
+// is kept in state (as tainted) even if it never becomes readable.
+func readAfterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, read schema.ReadContextFunc) diag.Diagnostics {
+	id := d.Id()
+	var diags diag.Diagnostics
+
+	err := resource.RetryContext(ctx, createReadRetryTimeout, func() *resource.RetryError {
+		diags = read(ctx, d, meta)
+		if diags.HasError() {
 			return nil
 		}
+		if d.Id() == "" {
+			// handleNonExistentResource cleared the id because of a 404
+			log.Printf("[DEBUG] %s not found right after creation, retrying", id)
+			d.SetId(id)
+			return resource.RetryableError(fmt.Errorf("%s could not be found after creation", id))
+		}
+		return nil
+	})
+	if err != nil {
+		d.SetId(id)
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
 
+// retryNotFoundAfterCreate runs f, which reads or changes the resource behind
+// d, and retries it while OpsGenie still reports a resource created in this
+// run as not found, the same way readAfterCreate does. For resources which
+// already existed, f runs once.
+func retryNotFoundAfterCreate(ctx context.Context, d *schema.ResourceData, f func() error) error {
+	if !d.IsNewResource() {
+		return f()
+	}
+	return resource.RetryContext(ctx, createReadRetryTimeout, func() *resource.RetryError {
+		err := f()
+		if isNotFoundError(err) {
+			log.Printf("[DEBUG] %s not found right after creation, retrying", d.Id())
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
 		return nil
+	})
+}
+
+// attributeDiagnostic returns an error diagnostic that points at the given
+// top level attribute, so that Terraform can highlight it in the configuration.
+func attributeDiagnostic(attribute, summary string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       summary,
+		Detail:        err.Error(),
+		AttributePath: cty.GetAttrPath(attribute),
 	}
 }
 
@@ -73,3 +138,61 @@ func convertStringSliceToInterfaceSlice(old []string) []interface{} {
 	}
 	return new
 }
+
+// nameRegexSchema is the optional name filter shared by the list data sources.
+func nameRegexSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+	}
+}
+
+// nameRegexMatcher returns a function that reports whether a name matches the
+// name_regex of a list data source. Every name matches when it is not set.
+func nameRegexMatcher(d *schema.ResourceData) func(string) bool {
+	v, ok := d.GetOk("name_regex")
+	if !ok {
+		return func(string) bool { return true }
+	}
+	// name_regex has already been validated by StringIsValidRegExp
+	re := regexp.MustCompile(v.(string))
+	return re.MatchString
+}
+
+// listDataSourceId returns a stable id for a list data source, derived from
+// the ids of the objects it found.
+func listDataSourceId(ids []string) string {
+	return strconv.Itoa(schema.HashString(strings.Join(ids, ",")))
+}
+
+// dataSourceSchemaFromResourceSchema turns the schema of a resource into the
+// schema of a data source that exports the same attributes, so that the
+// resource's Read function can populate the data source. Every attribute
+// becomes computed; arguments of the data source have to be set afterwards.
+func dataSourceSchemaFromResourceSchema(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(rs))
+	for k, v := range rs {
+		ds[k] = dataSourceAttributeFromResourceAttribute(v)
+	}
+	return ds
+}
+
+func dataSourceAttributeFromResourceAttribute(rs *schema.Schema) *schema.Schema {
+	ds := &schema.Schema{
+		Type:        rs.Type,
+		Computed:    true,
+		Set:         rs.Set,
+		Sensitive:   rs.Sensitive,
+		Description: rs.Description,
+	}
+	switch elem := rs.Elem.(type) {
+	case *schema.Resource:
+		ds.Elem = &schema.Resource{
+			Schema: dataSourceSchemaFromResourceSchema(elem.Schema),
+		}
+	case *schema.Schema:
+		ds.Elem = &schema.Schema{Type: elem.Type}
+	}
+	return ds
+}
@@ -0,0 +1,132 @@
+package opsgenie
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/opsgenie/opsgenie-go-sdk-v2/client"
+)
+
+func TestReadAfterCreate_retriesNotFound(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.SetId("test-id")
+
+	calls := 0
+	read := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		calls++
+		if calls < 3 {
+			d.SetId("")
+		}
+		return nil
+	}
+
+	if diags := readAfterCreate(context.Background(), d, nil, read); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 reads, got %d", calls)
+	}
+	if d.Id() != "test-id" {
+		t.Fatalf("expected id to be kept, got %q", d.Id())
+	}
+}
+
+func TestReadAfterCreate_keepsIdOnError(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.SetId("test-id")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	read := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		d.SetId("")
+		return nil
+	}
+
+	if diags := readAfterCreate(ctx, d, nil, read); !diags.HasError() {
+		t.Fatal("expected an error when the resource never becomes readable")
+	}
+	if d.Id() != "test-id" {
+		t.Fatalf("expected id to be kept, got %q", d.Id())
+	}
+}
+
+func TestRetryNotFoundAfterCreate(t *testing.T) {
+	notFound := &client.ApiError{StatusCode: http.StatusNotFound}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.SetId("test-id")
+	d.MarkNewResource()
+
+	calls := 0
+	err := retryNotFoundAfterCreate(context.Background(), d, func() error {
+		calls++
+		if calls < 3 {
+			return notFound
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+
+	existing := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	existing.SetId("test-id")
+
+	calls = 0
+	err = retryNotFoundAfterCreate(context.Background(), existing, func() error {
+		calls++
+		return notFound
+	})
+	if !isNotFoundError(err) {
+		t.Fatalf("expected the not found error, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected existing resources not to be retried, got %d calls", calls)
+	}
+}
+
+func TestDataSourceSchemaFromResourceSchema(t *testing.T) {
+	rs := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"filter": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:    schema.TypeString,
+						Default: "match-all",
+					},
+				},
+			},
+		},
+	}
+
+	ds := dataSourceSchemaFromResourceSchema(rs)
+
+	if !ds["name"].Computed || ds["name"].Required || ds["name"].ForceNew {
+		t.Fatalf("expected name to only be computed, got %#v", ds["name"])
+	}
+	if !ds["filter"].Computed || ds["filter"].Optional || ds["filter"].MaxItems != 0 {
+		t.Fatalf("expected filter to only be computed, got %#v", ds["filter"])
+	}
+	nested := ds["filter"].Elem.(*schema.Resource).Schema["type"]
+	if !nested.Computed || nested.Default != nil {
+		t.Fatalf("expected nested attributes to only be computed, got %#v", nested)
+	}
+	if !rs["name"].Required {
+		t.Fatal("expected the resource schema to be left untouched")
+	}
+}